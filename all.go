@@ -0,0 +1,83 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+
+	"github.com/mbark/aoc2025/profile"
+	"github.com/mbark/aoc2025/registry"
+	"github.com/mbark/aoc2025/runner"
+	"github.com/mbark/aoc2025/util"
+)
+
+func all(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("all", flag.ExitOnError)
+	var (
+		flagYear = yearFlag(fs)
+		flagTest = fs.Bool("test", false, "run the examples instead of the inputs")
+		format   = fs.String("format", "table", "output format: table or json")
+	)
+	setupCache := cacheFlags(fs)
+	options := runFlags(fs)
+	profiling := profileFlags(fs)
+	_ = fs.Parse(args)
+	if err := setupCache(); err != nil {
+		return err
+	}
+	opts := options()
+	prof, err := profiling()
+	if err != nil {
+		return err
+	}
+
+	write := map[string]func(io.Writer, []runner.Result) error{
+		"table": runner.WriteTable,
+		"json":  runner.WriteJSON,
+	}[*format]
+	if write == nil {
+		return fmt.Errorf("unknown format %q", *format)
+	}
+
+	solvers := slices.DeleteFunc(registry.All(), func(s registry.Solver) bool { return s.Year != *flagYear })
+	if err := checkParts(opts, solvers...); err != nil {
+		return err
+	}
+
+	var results []runner.Result
+	for _, s := range solvers {
+		if ctx.Err() != nil {
+			break
+		}
+		var input string
+		if !*flagTest {
+			input, err = util.GetInput(s.Year, s.Day)
+			if err != nil {
+				results = append(results, runner.Result{Year: s.Year, Day: s.Day, Err: err})
+				continue
+			}
+		}
+
+		// each day gets its own profile so they can be compared
+		err = prof.run(ctx, profile.DayPath(prof.path, s.Day), func() {
+			if !*flagTest {
+				results = append(results, runner.Run(ctx, s, input, opts)...)
+				return
+			}
+			for _, e := range s.Examples {
+				results = append(results, runner.RunExample(ctx, s, e, opts)...)
+			}
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := write(os.Stdout, results); err != nil {
+		return err
+	}
+	return failures(results)
+}
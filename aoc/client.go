@@ -0,0 +1,111 @@
+package aoc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+)
+
+const (
+	DefaultBaseURL = "https://adventofcode.com"
+	// UserAgent identifies the client. The Advent of Code automation
+	// guidelines ask for contact details as well, which NewClient adds from
+	// $AOC_CONTACT.
+	UserAgent = "github.com/mbark/aoc2025"
+)
+
+var (
+	ErrNoSession   = errors.New("no session cookie set, export SESSION")
+	ErrNotUnlocked = errors.New("puzzle not found, it might not be unlocked yet")
+)
+
+// StatusError is returned for any unexpected response from the server, e.g.
+// a 400 for a malformed request or a 500 for an invalid session.
+type StatusError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("unexpected status %d: %s", e.StatusCode, e.Body)
+}
+
+// Client talks to the Advent of Code website.
+type Client struct {
+	BaseURL    string
+	HTTPClient *http.Client
+	Session    string
+	UserAgent  string
+}
+
+func NewClient(session string) *Client {
+	return &Client{
+		BaseURL:    DefaultBaseURL,
+		HTTPClient: http.DefaultClient,
+		Session:    session,
+		UserAgent:  userAgent(strings.TrimSpace(os.Getenv("AOC_CONTACT"))),
+	}
+}
+
+// userAgent adds the contact, e.g. an email address, to UserAgent.
+func userAgent(contact string) string {
+	if contact == "" {
+		return UserAgent
+	}
+	return UserAgent + " by " + contact
+}
+
+// SessionFromEnv returns the session cookie stored in $SESSION.
+func SessionFromEnv() string {
+	return strings.TrimSpace(os.Getenv("SESSION"))
+}
+
+// Input downloads the puzzle input for the day.
+func (c *Client) Input(ctx context.Context, year, day int) (string, error) {
+	b, err := c.do(ctx, http.MethodGet, fmt.Sprintf("/%d/day/%d/input", year, day), nil)
+	if err != nil {
+		return "", fmt.Errorf("get input for %d day %d: %w", year, day, err)
+	}
+
+	return string(b), nil
+}
+
+func (c *Client) do(ctx context.Context, method, path string, body io.Reader) ([]byte, error) {
+	if c.Session == "" {
+		return nil, ErrNoSession
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, strings.TrimSuffix(c.BaseURL, "/")+path, body)
+	if err != nil {
+		return nil, err
+	}
+	req.AddCookie(&http.Cookie{Name: "session", Value: c.Session})
+	req.Header.Set("User-Agent", c.UserAgent)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	b, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	switch res.StatusCode {
+	case http.StatusOK:
+		return b, nil
+	case http.StatusNotFound:
+		return nil, ErrNotUnlocked
+	default:
+		return nil, &StatusError{StatusCode: res.StatusCode, Body: strings.TrimSpace(string(b))}
+	}
+}
@@ -0,0 +1,89 @@
+package aoc
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func testClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	c := NewClient("secret")
+	c.BaseURL = srv.URL
+	return c
+}
+
+func TestInput(t *testing.T) {
+	t.Setenv("AOC_CONTACT", "me@example.com")
+	c := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/2025/day/3/input" {
+			t.Errorf("path = %s, want /2025/day/3/input", r.URL.Path)
+		}
+		if cookie, err := r.Cookie("session"); err != nil || cookie.Value != "secret" {
+			t.Errorf("session cookie = %v, %v, want secret", cookie, err)
+		}
+		if ua, want := r.Header.Get("User-Agent"), UserAgent+" by me@example.com"; ua != want {
+			t.Errorf("User-Agent = %q, want %q", ua, want)
+		}
+		_, _ = w.Write([]byte("1 2 3\n"))
+	})
+
+	input, err := c.Input(t.Context(), 2025, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if input != "1 2 3\n" {
+		t.Errorf("input = %q, want %q", input, "1 2 3\n")
+	}
+}
+
+func TestNoSession(t *testing.T) {
+	c := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request sent without a session")
+	})
+	c.Session = ""
+
+	if _, err := c.Input(t.Context(), 2025, 1); !errors.Is(err, ErrNoSession) {
+		t.Errorf("got %v, want ErrNoSession", err)
+	}
+}
+
+func TestStatusErrors(t *testing.T) {
+	tests := []struct {
+		status     int
+		notFound   bool
+		statusCode int
+	}{
+		{status: http.StatusNotFound, notFound: true},
+		{status: http.StatusBadRequest, statusCode: http.StatusBadRequest},
+		{status: http.StatusInternalServerError, statusCode: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		c := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "nope", tt.status)
+		})
+
+		_, err := c.Input(t.Context(), 2025, 1)
+		if tt.notFound {
+			if !errors.Is(err, ErrNotUnlocked) {
+				t.Errorf("%d: got %v, want ErrNotUnlocked", tt.status, err)
+			}
+			continue
+		}
+
+		var se *StatusError
+		if !errors.As(err, &se) {
+			t.Errorf("%d: got %v, want a StatusError", tt.status, err)
+			continue
+		}
+		if se.StatusCode != tt.statusCode || se.Body != "nope" {
+			t.Errorf("%d: got status %d with body %q", tt.status, se.StatusCode, se.Body)
+		}
+	}
+}
@@ -0,0 +1,127 @@
+package aoc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"math/big"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+var (
+	ErrSubmitted   = errors.New("answer already submitted")
+	ErrSolved      = errors.New("part already solved")
+	ErrOutOfBounds = errors.New("answer outside the known bounds")
+)
+
+// Submission is an answer that has been submitted and the server's verdict.
+type Submission struct {
+	Part    int       `json:"part"`
+	Answer  string    `json:"answer"`
+	Outcome Outcome   `json:"outcome"`
+	Time    time.Time `json:"time"`
+}
+
+// History is the log of every answer submitted for a day, stored as JSON.
+type History struct {
+	path        string
+	Submissions []Submission
+}
+
+// LoadHistory reads the day's history from dir, a missing file is an empty
+// history.
+func LoadHistory(dir string, year, day int) (*History, error) {
+	h := &History{path: filepath.Join(dir, fmt.Sprintf("%d", year), fmt.Sprintf("day%02d.answers.json", day))}
+
+	b, err := os.ReadFile(h.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return h, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(b, &h.Submissions); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", h.path, err)
+	}
+	return h, nil
+}
+
+// Bounds returns the range an answer to the part must be in, based on the
+// answers that were too low or too high. Either bound is nil if unknown and
+// both are exclusive.
+func (h *History) Bounds(part int) (low, high *big.Int) {
+	for _, s := range h.Submissions {
+		if s.Part != part {
+			continue
+		}
+		n, ok := new(big.Int).SetString(s.Answer, 10)
+		if !ok {
+			continue
+		}
+
+		switch s.Outcome {
+		case TooLow:
+			if low == nil || n.Cmp(low) > 0 {
+				low = n
+			}
+		case TooHigh:
+			if high == nil || n.Cmp(high) < 0 {
+				high = n
+			}
+		}
+	}
+
+	return low, high
+}
+
+// Check returns an error if there is no point in submitting the answer, either
+// because it has been submitted before, the part is already solved or the
+// answer is outside the bounds given by earlier answers.
+func (h *History) Check(part int, answer string) error {
+	for _, s := range h.Submissions {
+		if s.Part != part {
+			continue
+		}
+
+		switch {
+		case s.Outcome == Correct && s.Answer == answer:
+			return fmt.Errorf("%w: %s was correct", ErrSubmitted, answer)
+		case s.Outcome == Correct:
+			return fmt.Errorf("%w: the answer was %s", ErrSolved, s.Answer)
+		case s.Outcome.IsWrong() && s.Answer == answer:
+			return fmt.Errorf("%w: %s was %s at %s", ErrSubmitted, answer, s.Outcome, s.Time.Format(time.DateTime))
+		}
+	}
+
+	n, ok := new(big.Int).SetString(answer, 10)
+	if !ok {
+		return nil
+	}
+
+	low, high := h.Bounds(part)
+	if low != nil && n.Cmp(low) <= 0 {
+		return fmt.Errorf("%w: %s is not above %s which was too low", ErrOutOfBounds, answer, low)
+	}
+	if high != nil && n.Cmp(high) >= 0 {
+		return fmt.Errorf("%w: %s is not below %s which was too high", ErrOutOfBounds, answer, high)
+	}
+	return nil
+}
+
+// Add appends the submission and saves the history.
+func (h *History) Add(s Submission) error {
+	h.Submissions = append(h.Submissions, s)
+
+	b, err := json.MarshalIndent(h.Submissions, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(h.path), 0o700); err != nil {
+		return err
+	}
+	return os.WriteFile(h.path, b, 0o600)
+}
@@ -0,0 +1,41 @@
+package aoc
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestHistoryCheck(t *testing.T) {
+	h := &History{Submissions: []Submission{
+		{Part: 1, Answer: "100", Outcome: TooLow},
+		{Part: 1, Answer: "500", Outcome: TooHigh},
+		{Part: 1, Answer: "300", Outcome: TooHigh},
+		{Part: 1, Answer: "250", Outcome: Incorrect},
+		{Part: 1, Answer: "200", Outcome: Wait},
+		{Part: 2, Answer: "42", Outcome: Correct},
+	}}
+
+	tests := []struct {
+		part   int
+		answer string
+		err    error
+	}{
+		{1, "150", nil},
+		{1, "200", nil},
+		{1, "250", ErrSubmitted},
+		{1, "100", ErrSubmitted},
+		{1, "99", ErrOutOfBounds},
+		{1, "300", ErrSubmitted},
+		{1, "400", ErrOutOfBounds},
+		{1, "abc", nil},
+		{2, "42", ErrSubmitted},
+		{2, "43", ErrSolved},
+	}
+
+	for _, tt := range tests {
+		err := h.Check(tt.part, tt.answer)
+		if !errors.Is(err, tt.err) || (tt.err == nil && err != nil) {
+			t.Errorf("Check(%d, %s) = %v, want %v", tt.part, tt.answer, err, tt.err)
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package aoc
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// Leaderboard is a private leaderboard as returned by its JSON API.
+type Leaderboard struct {
+	Event   string `json:"event"`
+	OwnerID int    `json:"owner_id"`
+	// Day1TS is when the first puzzle unlocked, it is missing for older
+	// events.
+	Day1TS  int64             `json:"day1_ts"`
+	Members map[string]Member `json:"members"`
+}
+
+type Member struct {
+	ID         int    `json:"id"`
+	Name       string `json:"name"`
+	Stars      int    `json:"stars"`
+	LocalScore int    `json:"local_score"`
+	LastStarTS int64  `json:"last_star_ts"`
+	// Days holds the member's stars keyed by day and part.
+	Days map[int]map[int]Star `json:"completion_day_level"`
+}
+
+type Star struct {
+	TS    int64 `json:"get_star_ts"`
+	Index int   `json:"star_index"`
+}
+
+// LeaderboardJSON downloads the private leaderboard. The site asks that it
+// isn't fetched more than once every 15 minutes so it should be cached.
+func (c *Client) LeaderboardJSON(ctx context.Context, year int, id string) ([]byte, error) {
+	b, err := c.do(ctx, http.MethodGet, fmt.Sprintf("/%d/leaderboard/private/view/%s.json", year, id), nil)
+	if err != nil {
+		return nil, fmt.Errorf("get leaderboard %s for %d: %w", id, year, err)
+	}
+
+	return b, nil
+}
+
+func ParseLeaderboard(b []byte) (Leaderboard, error) {
+	var l Leaderboard
+	if err := json.Unmarshal(b, &l); err != nil {
+		return Leaderboard{}, fmt.Errorf("parse leaderboard: %w", err)
+	}
+	return l, nil
+}
+
+// DisplayName is the member's name, or what the site shows for anonymous
+// members.
+func (m Member) DisplayName() string {
+	if m.Name == "" {
+		return fmt.Sprintf("(anonymous user #%d)", m.ID)
+	}
+	return m.Name
+}
+
+// Ranking returns the members ordered by local score, ties going to whoever
+// got their last star first.
+func (l Leaderboard) Ranking() []Member {
+	members := make([]Member, 0, len(l.Members))
+	for _, m := range l.Members {
+		members = append(members, m)
+	}
+
+	slices.SortFunc(members, func(a, b Member) int {
+		return cmp.Or(
+			cmp.Compare(b.LocalScore, a.LocalScore),
+			cmp.Compare(b.Stars, a.Stars),
+			cmp.Compare(a.LastStarTS, b.LastStarTS),
+			cmp.Compare(a.ID, b.ID),
+		)
+	})
+	return members
+}
+
+// Days returns the days anyone has a star for, in order.
+func (l Leaderboard) Days() []int {
+	var days []int
+	for _, m := range l.Members {
+		for day := range m.Days {
+			if !slices.Contains(days, day) {
+				days = append(days, day)
+			}
+		}
+	}
+
+	slices.Sort(days)
+	return days
+}
+
+// Unlock returns when the day's puzzle unlocked, midnight EST.
+func (l Leaderboard) Unlock(day int) time.Time {
+	if l.Day1TS != 0 {
+		return time.Unix(l.Day1TS, 0).AddDate(0, 0, day-1)
+	}
+
+	year, _ := strconv.Atoi(l.Event)
+	return time.Date(year, time.December, day, 5, 0, 0, 0, time.UTC)
+}
+
+// Completion returns how long after the puzzle unlocked the member got the
+// part's star, ok is false if they haven't got it.
+func (l Leaderboard) Completion(m Member, day, part int) (d time.Duration, ok bool) {
+	star, ok := m.Days[day][part]
+	if !ok {
+		return 0, false
+	}
+	return time.Unix(star.TS, 0).Sub(l.Unlock(day)), true
+}
+
+// Delta returns the time between the member's first and second star for the
+// day, ok is false unless they have both.
+func (m Member) Delta(day int) (d time.Duration, ok bool) {
+	first, ok1 := m.Days[day][1]
+	second, ok2 := m.Days[day][2]
+	if !ok1 || !ok2 {
+		return 0, false
+	}
+	return time.Duration(second.TS-first.TS) * time.Second, true
+}
@@ -0,0 +1,63 @@
+package aoc
+
+import (
+	"os"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestLeaderboard(t *testing.T) {
+	b, err := os.ReadFile("testdata/leaderboard.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	l, err := ParseLeaderboard(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var names []string
+	for _, m := range l.Ranking() {
+		names = append(names, m.DisplayName())
+	}
+	if want := []string{"alice", "bob", "(anonymous user #303)", "carol"}; !slices.Equal(names, want) {
+		t.Errorf("ranking = %q, want %q", names, want)
+	}
+	if got := l.Days(); !slices.Equal(got, []int{1, 2}) {
+		t.Errorf("days = %v, want [1 2]", got)
+	}
+
+	bob := l.Members["202"]
+	tests := []struct {
+		day, part int
+		want      time.Duration
+		ok        bool
+	}{
+		{1, 1, 200 * time.Second, true},
+		{1, 2, 3800 * time.Second, true},
+		{2, 1, 600 * time.Second, true},
+		{2, 2, 0, false},
+	}
+	for _, tt := range tests {
+		got, ok := l.Completion(bob, tt.day, tt.part)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("day %d part %d = %s, %t, want %s, %t", tt.day, tt.part, got, ok, tt.want, tt.ok)
+		}
+	}
+
+	if d, ok := bob.Delta(1); d != time.Hour || !ok {
+		t.Errorf("delta day 1 = %s, %t, want 1h, true", d, ok)
+	}
+	if _, ok := bob.Delta(2); ok {
+		t.Error("delta for a day with one star")
+	}
+}
+
+func TestUnlockWithoutDay1TS(t *testing.T) {
+	l := Leaderboard{Event: "2023"}
+	want := time.Date(2023, time.December, 3, 0, 0, 0, 0, time.FixedZone("EST", -5*60*60))
+	if got := l.Unlock(3); !got.Equal(want) {
+		t.Errorf("unlock = %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,83 @@
+package aoc
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+// Puzzle is what can be parsed from a puzzle's description page.
+type Puzzle struct {
+	Title string
+	// Parts holds the description of each part that has been unlocked.
+	Parts []PuzzlePart
+}
+
+type PuzzlePart struct {
+	// Examples are the code blocks in the description, usually the example
+	// input is the first one.
+	Examples []string
+	// Answer is the last emphasised code in the description, which is
+	// usually the answer for the example.
+	Answer string
+}
+
+// Puzzle downloads and parses the description of the day's puzzle.
+func (c *Client) Puzzle(ctx context.Context, year, day int) (Puzzle, error) {
+	b, err := c.do(ctx, http.MethodGet, fmt.Sprintf("/%d/day/%d", year, day), nil)
+	if err != nil {
+		return Puzzle{}, fmt.Errorf("get puzzle for %d day %d: %w", year, day, err)
+	}
+
+	return ParsePuzzle(string(b)), nil
+}
+
+var (
+	reTitle    = regexp.MustCompile(`<h2>--- Day \d+: (.*?) ---</h2>`)
+	reDesc     = regexp.MustCompile(`(?s)<article class="day-desc">(.*?)</article>`)
+	rePre      = regexp.MustCompile(`(?s)<pre><code>(.*?)</code></pre>`)
+	reEmphasis = regexp.MustCompile(`(?s)<code><em>(.*?)</em></code>`)
+)
+
+// ParsePuzzle parses the HTML of a puzzle's description page.
+func ParsePuzzle(page string) Puzzle {
+	var p Puzzle
+	if m := reTitle.FindStringSubmatch(page); m != nil {
+		p.Title = html.UnescapeString(m[1])
+	}
+
+	for _, desc := range reDesc.FindAllStringSubmatch(page, -1) {
+		var part PuzzlePart
+		for _, m := range rePre.FindAllStringSubmatch(desc[1], -1) {
+			part.Examples = append(part.Examples, text(m[1]))
+		}
+		if ms := reEmphasis.FindAllStringSubmatch(desc[1], -1); ms != nil {
+			part.Answer = text(ms[len(ms)-1][1])
+		}
+
+		p.Parts = append(p.Parts, part)
+	}
+
+	return p
+}
+
+// text strips any tags and unescapes the HTML.
+func text(s string) string {
+	return html.UnescapeString(reTag.ReplaceAllString(s, ""))
+}
+
+// Example returns the first code block, which is usually the example input,
+// and the example's answer for each part. It is empty if nothing is found.
+func (p Puzzle) Example() (input string, answers []string) {
+	for _, part := range p.Parts {
+		if input == "" && len(part.Examples) > 0 {
+			input = part.Examples[0]
+		}
+		answers = append(answers, strings.TrimSpace(part.Answer))
+	}
+
+	return input, answers
+}
@@ -0,0 +1,36 @@
+package aoc
+
+import (
+	"os"
+	"slices"
+	"testing"
+)
+
+func TestParsePuzzle(t *testing.T) {
+	b, err := os.ReadFile("testdata/puzzle.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p := ParsePuzzle(string(b))
+	if p.Title != "Printing Department" {
+		t.Errorf("title = %q", p.Title)
+	}
+	if len(p.Parts) != 2 {
+		t.Fatalf("got %d parts, want 2", len(p.Parts))
+	}
+	if len(p.Parts[0].Examples) != 2 || len(p.Parts[1].Examples) != 1 {
+		t.Errorf("got %d and %d examples, want 2 and 1", len(p.Parts[0].Examples), len(p.Parts[1].Examples))
+	}
+	if got, want := p.Parts[1].Examples[0], "Remove 13 rolls & repeat\n"; got != want {
+		t.Errorf("example = %q, want %q", got, want)
+	}
+
+	input, answers := p.Example()
+	if input[:11] != "..@@.@@@@.\n" || len(input) != 110 {
+		t.Errorf("example input = %q", input)
+	}
+	if !slices.Equal(answers, []string{"13", "43"}) {
+		t.Errorf("answers = %v, want [13 43]", answers)
+	}
+}
@@ -0,0 +1,131 @@
+package aoc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Outcome is the verdict the server gave on a submitted answer.
+type Outcome int
+
+const (
+	Unknown Outcome = iota
+	Correct
+	Incorrect
+	TooHigh
+	TooLow
+	Wait
+	AlreadySolved
+)
+
+func (o Outcome) String() string {
+	switch o {
+	case Correct:
+		return "correct"
+	case Incorrect:
+		return "incorrect"
+	case TooHigh:
+		return "too high"
+	case TooLow:
+		return "too low"
+	case Wait:
+		return "wait"
+	case AlreadySolved:
+		return "already solved"
+	default:
+		return "unknown"
+	}
+}
+
+func (o Outcome) MarshalText() ([]byte, error) {
+	return []byte(o.String()), nil
+}
+
+func (o *Outcome) UnmarshalText(b []byte) error {
+	for out := Unknown; out <= AlreadySolved; out++ {
+		if out.String() == string(b) {
+			*o = out
+			return nil
+		}
+	}
+
+	return fmt.Errorf("unknown outcome %q", b)
+}
+
+// IsWrong is true if the server has said the answer isn't correct.
+func (o Outcome) IsWrong() bool {
+	return o == Incorrect || o == TooHigh || o == TooLow
+}
+
+// Response is the parsed reply to a submitted answer.
+type Response struct {
+	Outcome Outcome
+	// Wait is how long to wait before submitting again, if the server said so.
+	Wait    time.Duration
+	Message string
+}
+
+// Submit posts the answer for one part of the day's puzzle.
+func (c *Client) Submit(ctx context.Context, year, day, part int, answer string) (Response, error) {
+	form := url.Values{"level": {strconv.Itoa(part)}, "answer": {answer}}
+	b, err := c.do(ctx, http.MethodPost, fmt.Sprintf("/%d/day/%d/answer", year, day), strings.NewReader(form.Encode()))
+	if err != nil {
+		return Response{}, fmt.Errorf("submit answer for %d day %d part %d: %w", year, day, part, err)
+	}
+
+	return ParseResponse(string(b)), nil
+}
+
+var (
+	reArticle  = regexp.MustCompile(`(?s)<article[^>]*>(.*?)</article>`)
+	reTag      = regexp.MustCompile(`<[^>]*>`)
+	reSpace    = regexp.MustCompile(`\s+`)
+	reLeft     = regexp.MustCompile(`You have (?:(\d+)m )?(\d+)s left to wait`)
+	reWaitMins = regexp.MustCompile(`[Pp]lease wait (one|\d+) minutes? before trying again`)
+)
+
+// ParseResponse parses the HTML page returned when submitting an answer.
+func ParseResponse(html string) Response {
+	message := html
+	if m := reArticle.FindStringSubmatch(html); m != nil {
+		message = m[1]
+	}
+	message = reTag.ReplaceAllString(message, "")
+	message = strings.TrimSpace(reSpace.ReplaceAllString(message, " "))
+
+	r := Response{Message: message}
+	switch {
+	case strings.Contains(message, "That's the right answer"):
+		r.Outcome = Correct
+	case strings.Contains(message, "your answer is too high"):
+		r.Outcome = TooHigh
+	case strings.Contains(message, "your answer is too low"):
+		r.Outcome = TooLow
+	case strings.Contains(message, "That's not the right answer"):
+		r.Outcome = Incorrect
+	case strings.Contains(message, "You gave an answer too recently"):
+		r.Outcome = Wait
+	case strings.Contains(message, "You don't seem to be solving the right level"):
+		r.Outcome = AlreadySolved
+	}
+
+	if m := reLeft.FindStringSubmatch(message); m != nil {
+		minutes, _ := strconv.Atoi(m[1])
+		seconds, _ := strconv.Atoi(m[2])
+		r.Wait = time.Duration(minutes)*time.Minute + time.Duration(seconds)*time.Second
+	} else if m := reWaitMins.FindStringSubmatch(message); m != nil {
+		minutes := 1
+		if m[1] != "one" {
+			minutes, _ = strconv.Atoi(m[1])
+		}
+		r.Wait = time.Duration(minutes) * time.Minute
+	}
+
+	return r
+}
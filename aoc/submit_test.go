@@ -0,0 +1,39 @@
+package aoc
+
+import (
+	"os"
+	"testing"
+	"time"
+)
+
+func TestParseResponse(t *testing.T) {
+	tests := []struct {
+		fixture string
+		outcome Outcome
+		wait    time.Duration
+	}{
+		{"answer_correct.html", Correct, 0},
+		{"answer_too_high.html", TooHigh, time.Minute},
+		{"answer_too_low.html", TooLow, time.Minute},
+		{"answer_incorrect.html", Incorrect, 5 * time.Minute},
+		{"answer_wait.html", Wait, time.Minute + 34*time.Second},
+		{"answer_already_solved.html", AlreadySolved, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			b, err := os.ReadFile("testdata/" + tt.fixture)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			r := ParseResponse(string(b))
+			if r.Outcome != tt.outcome {
+				t.Errorf("outcome = %s, want %s (message: %s)", r.Outcome, tt.outcome, r.Message)
+			}
+			if r.Wait != tt.wait {
+				t.Errorf("wait = %s, want %s", r.Wait, tt.wait)
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+
+	"github.com/mbark/aoc2025/registry"
+	"github.com/mbark/aoc2025/runner"
+)
+
+func bench(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("bench", flag.ExitOnError)
+	var (
+		flagYear = yearFlag(fs)
+		flagDay  = fs.Int("day", 0, "day to benchmark, 0 for every day")
+		flagTest = fs.Bool("test", false, "benchmark the examples instead of the inputs")
+		warmup   = fs.Int("warmup", 10, "number of runs before measuring")
+		runs     = fs.Int("n", 100, "number of measured runs")
+		format   = fs.String("format", "table", "output format: table, csv or json")
+		label    = fs.String("label", "", "label added to csv and json output, e.g. a commit")
+		path     = fs.String("input", "", "read the input from a file, or stdin if -, requires -day")
+		params   paramFlag
+	)
+	fs.Var(&params, "param", "override a puzzle parameter, as name=value, requires -day")
+	setupCache := cacheFlags(fs)
+	options := runFlags(fs)
+	_ = fs.Parse(args)
+	if err := setupCache(); err != nil {
+		return err
+	}
+	opts := options()
+
+	write := map[string]func(io.Writer, []runner.Benchmark) error{
+		"table": runner.WriteBenchTable,
+		"csv":   runner.WriteBenchCSV,
+		"json":  runner.WriteBenchJSON,
+	}[*format]
+	if write == nil {
+		return fmt.Errorf("unknown format %q", *format)
+	}
+
+	if *runs < 1 {
+		return fmt.Errorf("-n must be at least 1")
+	}
+	if *warmup < 0 {
+		return fmt.Errorf("-warmup can't be negative")
+	}
+	if len(params) > 0 && *flagDay == 0 {
+		return fmt.Errorf("-param requires -day")
+	}
+	if *path != "" && (*flagDay == 0 || *flagTest) {
+		return fmt.Errorf("-input requires -day and can't be combined with -test")
+	}
+
+	solvers := slices.DeleteFunc(registry.All(), func(s registry.Solver) bool { return s.Year != *flagYear })
+	if *flagDay != 0 {
+		s, ok := registry.Get(*flagYear, *flagDay)
+		if !ok {
+			return fmt.Errorf("%d day %d not implemented", *flagYear, *flagDay)
+		}
+		solvers = []registry.Solver{s}
+	}
+	if err := checkParts(opts, solvers...); err != nil {
+		return err
+	}
+
+	var benchmarks []runner.Benchmark
+	for _, s := range solvers {
+		if ctx.Err() != nil {
+			break
+		}
+		overrides, err := s.ParseParams(params)
+		if err != nil {
+			return err
+		}
+		opts.Overrides = overrides
+
+		var bs []runner.Benchmark
+		if *flagTest {
+			for _, e := range s.Examples {
+				bs = append(bs, runner.BenchExample(ctx, s, e, opts, *warmup, *runs)...)
+			}
+		} else {
+			input, err := readInput(s.Year, s.Day, *path)
+			if err != nil {
+				benchmarks = append(benchmarks, runner.Benchmark{Year: s.Year, Day: s.Day, Err: err})
+				continue
+			}
+			bs = runner.Bench(ctx, s, input, opts, *warmup, *runs)
+		}
+
+		for _, b := range bs {
+			b.Label = *label
+			benchmarks = append(benchmarks, b)
+		}
+	}
+
+	return write(os.Stdout, benchmarks)
+}
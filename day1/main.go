@@ -1,8 +1,9 @@
 package day1
 
 import (
-	"fmt"
+	"context"
 
+	"github.com/mbark/aoc2025/registry"
 	"github.com/mbark/aoc2025/util"
 )
 
@@ -19,11 +20,31 @@ R14
 L82
 `
 
-func Run(input string, isTest bool) {
-	if isTest {
-		input = testInput
-	}
+func init() {
+	registry.Register(registry.Solver{
+		Name: "Secret Entrance",
+		Year: 2025,
+		Day:  1,
+		Examples: []registry.Example{
+			{
+				Name:    "example",
+				Input:   testInput,
+				Answers: map[int]registry.Answer{1: registry.Int(3), 2: registry.Int(6)},
+			},
+		},
+		Parts: []registry.Part{part1, part2},
+	})
+}
+
+func part1(_ context.Context, input string, _ registry.Params) (registry.Answer, error) {
+	return registry.Int(first(parse(input))), nil
+}
 
+func part2(_ context.Context, input string, _ registry.Params) (registry.Answer, error) {
+	return registry.Int(second(parse(input))), nil
+}
+
+func parse(input string) []instruction {
 	var instructions []instruction
 	for _, l := range util.ReadInput(input, "\n") {
 		instructions = append(instructions, instruction{
@@ -32,8 +53,7 @@ func Run(input string, isTest bool) {
 		})
 	}
 
-	fmt.Printf("first: %d\n", first(instructions))
-	fmt.Printf("second: %d\n", second(instructions))
+	return instructions
 }
 
 type instruction struct {
@@ -71,7 +91,7 @@ func second(instructions []instruction) int {
 			case 'R':
 				direction++
 			}
-		
+
 			direction = (direction + 100) % 100
 			if direction == 0 {
 				count++
@@ -0,0 +1,11 @@
+package day1
+
+import (
+	"testing"
+
+	"github.com/mbark/aoc2025/golden"
+)
+
+func TestGolden(t *testing.T) {
+	golden.Test(t, 2025, 1, golden.Answers{})
+}
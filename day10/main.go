@@ -2,14 +2,19 @@ package day10
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"os/exec"
+	"path/filepath"
+	"runtime"
 	"strconv"
 	"strings"
 
 	"github.com/mbark/aoc2025/fns"
+	"github.com/mbark/aoc2025/logging"
 	"github.com/mbark/aoc2025/maths"
+	"github.com/mbark/aoc2025/registry"
 	"github.com/mbark/aoc2025/util"
 )
 
@@ -19,44 +24,49 @@ var testInput = `
 [.###.#] (0,1,2,3,4) (0,3,4) (0,1,2,4,5) (1,2) {10,11,11,5,10,5}
 `
 
-func Run(input string, isTest bool) {
-	if isTest {
-		input = testInput
+func init() {
+	registry.Register(registry.Solver{
+		Name: "Factory",
+		Year: 2025,
+		Day:  10,
+		Examples: []registry.Example{
+			{
+				Name:    "example",
+				Input:   testInput,
+				Answers: map[int]registry.Answer{1: registry.Int(7), 2: registry.Int(33)},
+			},
+		},
+		Parts: []registry.Part{part1, part2},
+	})
+}
+
+func part1(_ context.Context, input string, _ registry.Params) (registry.Answer, error) {
+	diagrams, err := parse(input)
+	if err != nil {
+		return registry.Answer{}, err
 	}
 
-	var diagrams []Diagram
-	for _, l := range util.ReadInput(input, "\n") {
-		var d Diagram
-		lightsEnd := strings.Index(l, "]")
-		for _, b := range l[1:lightsEnd] {
-			if b == '#' {
-				d.Lights = append(d.Lights, true)
-			} else {
-				d.Lights = append(d.Lights, false)
-			}
-		}
+	return registry.Int(first(diagrams)), nil
+}
 
-		joltageStart := strings.Index(l, "{")
-		for _, s := range strings.Split(l[lightsEnd+2:joltageStart], " ") {
-			if s == "" {
-				continue
-			}
-			var button Button
-			for _, i := range strings.Split(s[1:len(s)-1], ",") {
-				button = append(button, util.Str2Int(i))
-			}
-			d.Buttons = append(d.Buttons, button)
-		}
+func part2(ctx context.Context, input string, _ registry.Params) (registry.Answer, error) {
+	diagrams, err := parse(input)
+	if err != nil {
+		return registry.Answer{}, err
+	}
 
-		for _, j := range strings.Split(l[joltageStart+1:len(l)-1], ",") {
-			d.Joltage = append(d.Joltage, util.Str2Int(j))
-		}
-		diagrams = append(diagrams, d)
+	sum, err := second(ctx, diagrams)
+	if err != nil {
+		return registry.Answer{}, err
 	}
 
-	fmt.Printf("first: %d\n", first(diagrams))
-	fmt.Printf("second: %d\n", second(diagrams))
+	return registry.Int(sum), nil
 }
+
+func parse(input string) ([]Diagram, error) {
+	return util.Parse[Diagram](input, "[{Lights}] {Buttons...} {{Joltage...}}")
+}
+
 func first(diagrams []Diagram) int {
 	var sum int
 	for _, d := range diagrams {
@@ -66,22 +76,23 @@ func first(diagrams []Diagram) int {
 	return sum
 }
 
-func noerr(err error) {
-	if err != nil {
-		fmt.Printf("%+v\n", err)
-		panic(err)
-	}
-}
-
-func second(diagrams []Diagram) int {
+func second(ctx context.Context, diagrams []Diagram) (int, error) {
+	log := logging.FromContext(ctx)
 	var sum int
 	for i, d := range diagrams {
-		fmt.Printf("%d/%d: %s\n", i, len(diagrams), d)
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+
+		log.Debug("solving diagram", "n", i+1, "of", len(diagrams), "diagram", d)
 		p := diagramToProblem(d)
-		sol := p.Solve()
+		sol, err := p.Solve(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("diagram %d: %w", i, err)
+		}
 		sum += sol
 	}
-	return sum
+	return sum, nil
 }
 
 func diagramToProblem(d Diagram) Problem {
@@ -104,6 +115,13 @@ func diagramToProblem(d Diagram) Problem {
 	return p
 }
 
+// script is the Python solver next to this file, found from the source path
+// so that it works both from the module root and from the package's tests.
+var script = func() string {
+	_, file, _, _ := runtime.Caller(0)
+	return filepath.Join(filepath.Dir(file), "day10.py")
+}()
+
 type Problem struct {
 	Variables []string         `json:"variables"`
 	Domains   map[string][]int `json:"domains"`
@@ -115,40 +133,32 @@ type Equation struct {
 	Value     int            `json:"value"`
 }
 
-func (problem Problem) Solve() int {
+func (problem Problem) Solve(ctx context.Context) (int, error) {
 	marshalled, err := json.Marshal(problem)
-	noerr(err)
-
-	cmd := exec.Command("uv", "run", "day10/day10.py")
-	stdin, err := cmd.StdinPipe()
-	noerr(err)
-
-	_, err = stdin.Write(marshalled)
-	noerr(err)
-
-	err = stdin.Close()
-	noerr(err)
+	if err != nil {
+		return 0, err
+	}
 
 	stdout, stderr := new(bytes.Buffer), new(bytes.Buffer)
+	cmd := exec.CommandContext(ctx, "uv", "run", script)
+	cmd.Stdin = bytes.NewReader(marshalled)
 	cmd.Stdout, cmd.Stderr = stdout, stderr
 	err = cmd.Run()
 	if err != nil {
-		fmt.Println(stderr.String())
-		fmt.Println(stdout.String())
+		return 0, fmt.Errorf("run solver: %w: %s", err, stderr.String())
 	}
-	noerr(err)
 
 	var res Result
 	err = json.Unmarshal(stdout.Bytes(), &res)
-	noerr(err)
+	if err != nil {
+		return 0, fmt.Errorf("unmarshal solver output: %w", err)
+	}
 
 	if res.Status != "success" {
-		fmt.Println(stderr.String())
-		fmt.Println(stdout.String())
-		panic("failed to find solution")
+		return 0, fmt.Errorf("failed to find solution: %s", stderr.String())
 	}
 
-	return res.Sum
+	return res.Sum, nil
 }
 
 type Result struct {
@@ -195,7 +205,7 @@ type clicksJ struct {
 
 type Diagram struct {
 	Lights  Lights
-	Buttons []Button
+	Buttons []Button `parse:"({...})"`
 	Joltage Joltage
 }
 
@@ -248,6 +258,20 @@ func (d Diagram) String() string {
 
 type Lights []bool
 
+func (l *Lights) UnmarshalText(text []byte) error {
+	*l = make(Lights, len(text))
+	for i, b := range text {
+		switch b {
+		case '#':
+			(*l)[i] = true
+		case '.':
+		default:
+			return fmt.Errorf("invalid light %q", b)
+		}
+	}
+	return nil
+}
+
 func (l Lights) String() string {
 	var sb strings.Builder
 	for _, b := range l {
@@ -0,0 +1,17 @@
+package day10
+
+import (
+	"os/exec"
+	"testing"
+
+	"github.com/mbark/aoc2025/golden"
+)
+
+func TestGolden(t *testing.T) {
+	skip := map[int]string{}
+	if _, err := exec.LookPath("uv"); err != nil {
+		skip[2] = "the second part solves the equations with z3 through uv, which isn't installed"
+	}
+
+	golden.Test(t, 2025, 10, golden.Answers{Skip: skip})
+}
@@ -1,9 +1,10 @@
 package day11
 
 import (
-	"fmt"
+	"context"
 	"strings"
 
+	"github.com/mbark/aoc2025/registry"
 	"github.com/mbark/aoc2025/util"
 )
 
@@ -35,28 +36,43 @@ fff: ggg hhh
 ggg: out
 hhh: out`
 
-func Run(input string, isTest bool) {
-	if isTest {
-		input = testInput
-	}
+func init() {
+	registry.Register(registry.Solver{
+		Name: "Reactor",
+		Year: 2025,
+		Day:  11,
+		Examples: []registry.Example{
+			{
+				Name:    "you",
+				Input:   testInput,
+				Answers: map[int]registry.Answer{1: registry.Int(5)},
+			},
+			{
+				Name:    "svr",
+				Input:   testInput2,
+				Answers: map[int]registry.Answer{2: registry.Int(2)},
+			},
+		},
+		Parts: []registry.Part{part1, part2},
+	})
+}
+
+func part1(_ context.Context, input string, _ registry.Params) (registry.Answer, error) {
+	return registry.Int(first(parse(input))), nil
+}
 
+func part2(_ context.Context, input string, _ registry.Params) (registry.Answer, error) {
+	return registry.Int(second(parse(input))), nil
+}
+
+func parse(input string) map[string][]string {
 	graph := map[string][]string{}
 	for _, l := range util.ReadInput(input, "\n") {
 		parts := strings.Split(l, ": ")
 		graph[parts[0]] = strings.Split(parts[1], " ")
 	}
-	fmt.Printf("first: %d\n", first(graph))
-
-	if isTest {
-		input = testInput2
-		graph = map[string][]string{}
-		for _, l := range util.ReadInput(input, "\n") {
-			parts := strings.Split(l, ": ")
-			graph[parts[0]] = strings.Split(parts[1], " ")
-		}
-	}
-	fmt.Printf("second: %d\n", second(graph))
 
+	return graph
 }
 
 func first(graph map[string][]string) int {
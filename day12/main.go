@@ -1,10 +1,12 @@
 package day12
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
 	"github.com/mbark/aoc2025/fns"
+	"github.com/mbark/aoc2025/registry"
 	"github.com/mbark/aoc2025/util"
 )
 
@@ -44,45 +46,50 @@ var testInput = `
 12x5: 1 0 1 0 3 2
 `
 
-func Run(input string, isTest bool) {
-	if isTest {
-		input = testInput
-	}
-	splits := util.ReadInput(input, "\n\n")
-	parsePoly := func(d string) Poly {
-		var poly Poly
-		for i, l := range util.ReadInput(d, "\n") {
-			if i == 0 {
-				continue
-			}
+func init() {
+	registry.Register(registry.Solver{
+		Name: "Christmas Tree Farm",
+		Year: 2025,
+		Day:  12,
+		Examples: []registry.Example{
+			{
+				// the area heuristic gets the example wrong, it gives 3, but
+				// it works for the real input.
+				Name:    "example",
+				Input:   testInput,
+				Answers: map[int]registry.Answer{1: registry.Int(2)},
+			},
+		},
+		Parts: []registry.Part{part1},
+	})
+}
 
-			poly = append(poly, []bool{l[0] == '#', l[1] == '#', l[2] == '#'})
-		}
-		return poly
+func part1(_ context.Context, input string, _ registry.Params) (registry.Answer, error) {
+	polys, problems, err := parse(input)
+	if err != nil {
+		return registry.Answer{}, err
 	}
-	polys := []Poly{
-		parsePoly(splits[0]),
-		parsePoly(splits[1]),
-		parsePoly(splits[2]),
-		parsePoly(splits[3]),
-		parsePoly(splits[4]),
-		parsePoly(splits[5]),
+
+	return registry.Int(first(polys, problems)), nil
+}
+
+func parse(input string) ([]Poly, []Problem, error) {
+	sections, err := util.SectionsN(input, 7)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	var problems []Problem
-	for _, l := range util.ReadInput(splits[6], "\n") {
-		s := strings.Split(l, ": ")
-		ss := strings.Split(s[0], "x")
-		rows := util.ParseInt[int](ss[0])
-		cols := util.ParseInt[int](ss[1])
-		problems = append(problems, Problem{
-			Rows:  rows,
-			Cols:  cols,
-			Polys: util.NumberList(s[1], " "),
-		})
+	var polys []Poly
+	for _, sec := range sections[:6] {
+		var poly Poly
+		for _, l := range sec.Lines() {
+			poly = append(poly, []bool{l[0] == '#', l[1] == '#', l[2] == '#'})
+		}
+		polys = append(polys, poly)
 	}
 
-	fmt.Printf("first: %d\n", first(polys, problems))
+	problems, err := util.ParseSection[Problem](sections[6], "{Rows}x{Cols}: {Polys...}")
+	return polys, problems, err
 }
 
 func first(polys []Poly, problems []Problem) int {
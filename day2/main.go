@@ -1,10 +1,11 @@
 package day2
 
 import (
-	"fmt"
+	"context"
 	"strconv"
 	"strings"
 
+	"github.com/mbark/aoc2025/registry"
 	"github.com/mbark/aoc2025/util"
 )
 
@@ -14,21 +15,40 @@ var testInput = `
 824824821-824824827,2121212118-2121212124
 `
 
-func Run(input string, isTest bool) {
-	if isTest {
-		input = strings.ReplaceAll(testInput, "\n", "")
-	}
+func init() {
+	registry.Register(registry.Solver{
+		Name: "Gift Shop",
+		Year: 2025,
+		Day:  2,
+		Examples: []registry.Example{
+			{
+				Name:    "example",
+				Input:   testInput,
+				Answers: map[int]registry.Answer{1: registry.Int(1227775554), 2: registry.Int(4174379265)},
+			},
+		},
+		Parts: []registry.Part{part1, part2},
+	})
+}
+
+func part1(_ context.Context, input string, _ registry.Params) (registry.Answer, error) {
+	return registry.Int(first(parse(input))), nil
+}
+
+func part2(_ context.Context, input string, _ registry.Params) (registry.Answer, error) {
+	return registry.Int(second(parse(input))), nil
+}
 
+func parse(input string) []rangePair {
 	var ranges []rangePair
-	for _, l := range util.ReadInput(input, ",") {
+	for _, l := range util.ReadInput(strings.ReplaceAll(input, "\n", ""), ",") {
 		ranges = append(ranges, rangePair{
 			min: util.ParseInt[int](strings.Split(l, "-")[0]),
 			max: util.ParseInt[int](strings.Split(l, "-")[1]),
 		})
 	}
 
-	fmt.Printf("first: %d\n", first(ranges))
-	fmt.Printf("second: %d\n", second(ranges))
+	return ranges
 }
 
 func first(ranges []rangePair) int {
@@ -1,10 +1,12 @@
 package day3
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
 	"github.com/mbark/aoc2025/fns"
+	"github.com/mbark/aoc2025/registry"
 	"github.com/mbark/aoc2025/util"
 )
 
@@ -15,19 +17,41 @@ var testInput = `
 818181911112111
 `
 
-func Run(input string, isTest bool) {
-	if isTest {
-		input = testInput
-	}
+func init() {
+	registry.Register(registry.Solver{
+		Name: "Lobby",
+		Year: 2025,
+		Day:  3,
+		Examples: []registry.Example{
+			{
+				Name:    "example",
+				Input:   testInput,
+				Answers: map[int]registry.Answer{1: registry.Int(357), 2: registry.Int(3121910778619)},
+			},
+		},
+		Params: []registry.Param{
+			{Name: "batteries", Usage: "number of batteries to turn on in each bank", Default: 12},
+		},
+		Parts: []registry.Part{part1, part2},
+	})
+}
+
+func part1(_ context.Context, input string, _ registry.Params) (registry.Answer, error) {
+	return registry.Int(first(parse(input))), nil
+}
+
+func part2(_ context.Context, input string, params registry.Params) (registry.Answer, error) {
+	return registry.Int(second(parse(input), params.Int("batteries"))), nil
+}
 
+func parse(input string) []battery {
 	var batteries []battery
 	for _, l := range util.ReadInput(input, "\n") {
 		joltage := fns.Map(strings.Split(l, ""), func(s string) int { return util.ParseInt[int](s) })
 		batteries = append(batteries, battery{joltage})
 	}
 
-	fmt.Printf("first: %d\n", first(batteries))
-	fmt.Printf("second: %d\n", second(batteries, 12))
+	return batteries
 }
 
 type battery struct {
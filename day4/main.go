@@ -1,9 +1,10 @@
 package day4
 
 import (
-	"fmt"
+	"context"
 
 	"github.com/mbark/aoc2025/maps"
+	"github.com/mbark/aoc2025/registry"
 )
 
 var testInput = `
@@ -19,15 +20,32 @@ var testInput = `
 @.@.@@@.@.
 `
 
-func Run(input string, isTest bool) {
-	if isTest {
-		input = testInput
-	}
+func init() {
+	registry.Register(registry.Solver{
+		Name: "Printing Department",
+		Year: 2025,
+		Day:  4,
+		Examples: []registry.Example{
+			{
+				Name:    "example",
+				Input:   testInput,
+				Answers: map[int]registry.Answer{1: registry.Int(13), 2: registry.Int(43)},
+			},
+		},
+		Parts: []registry.Part{part1, part2},
+	})
+}
 
-	m := maps.New(input, func(x, y int, b byte) bool { return b == '@' })
+func part1(_ context.Context, input string, _ registry.Params) (registry.Answer, error) {
+	return registry.Int(first(parse(input))), nil
+}
+
+func part2(_ context.Context, input string, _ registry.Params) (registry.Answer, error) {
+	return registry.Int(second(parse(input))), nil
+}
 
-	fmt.Printf("first %d\n", first(m))
-	fmt.Printf("second %d\n", second(m))
+func parse(input string) maps.Map[bool] {
+	return maps.New(input, func(x, y int, b byte) bool { return b == '@' })
 }
 
 func first(m maps.Map[bool]) int {
@@ -1,11 +1,13 @@
 package day5
 
 import (
+	"context"
 	"fmt"
 	"sort"
-	"strings"
 
 	"github.com/mbark/aoc2025/fns"
+	"github.com/mbark/aoc2025/logging"
+	"github.com/mbark/aoc2025/registry"
 	"github.com/mbark/aoc2025/util"
 )
 
@@ -31,25 +33,66 @@ var testInput2 = `
 1
 `
 
-func Run(input string, isTest bool) {
-	if isTest {
-		input = testInput2
+func init() {
+	registry.Register(registry.Solver{
+		Name: "Cafeteria",
+		Year: 2025,
+		Day:  5,
+		Examples: []registry.Example{
+			{
+				Name:    "example",
+				Input:   testInput,
+				Answers: map[int]registry.Answer{1: registry.Int(3), 2: registry.Int(14)},
+			},
+			{
+				Name:    "overlapping",
+				Input:   testInput2,
+				Answers: map[int]registry.Answer{1: registry.Int(1), 2: registry.Int(8)},
+			},
+		},
+		Parts: []registry.Part{part1, part2},
+	})
+}
+
+func part1(_ context.Context, input string, _ registry.Params) (registry.Answer, error) {
+	ranges, ingredients, err := parse(input)
+	if err != nil {
+		return registry.Answer{}, err
 	}
 
-	var ranges []Range
-	var ingredients []int
+	return registry.Int(first(ranges, ingredients)), nil
+}
 
-	splits := strings.Split(input, "\n\n")
-	for _, line := range util.ReadInput(splits[0], "\n") {
-		split := strings.Split(line, "-")
-		ranges = append(ranges, Range{util.ParseInt[int](split[0]), util.ParseInt[int](split[1])})
+func part2(ctx context.Context, input string, _ registry.Params) (registry.Answer, error) {
+	ranges, _, err := parse(input)
+	if err != nil {
+		return registry.Answer{}, err
 	}
-	for _, line := range util.ReadInput(splits[1], "\n") {
-		ingredients = append(ingredients, util.ParseInt[int](line))
+
+	return registry.Int(second(ctx, ranges)), nil
+}
+
+func parse(input string) ([]Range, []int, error) {
+	sections, err := util.SectionsN(input, 2)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var ranges []Range
+	for i, line := range sections[0].Lines() {
+		n := util.Ints(line)
+		if len(n) != 2 {
+			return nil, nil, fmt.Errorf("line %d: invalid range %q", sections[0].Line+i, line)
+		}
+		ranges = append(ranges, Range{n[0], n[1]})
+	}
+
+	ingredients, err := sections[1].Numbers("\n")
+	if err != nil {
+		return nil, nil, err
 	}
 
-	fmt.Printf("first: %d\n", first(ranges, ingredients))
-	fmt.Printf("second: %d\n", second(ranges))
+	return ranges, ingredients, nil
 }
 
 func first(ranges []Range, ingredients []int) int {
@@ -62,10 +105,11 @@ func first(ranges []Range, ingredients []int) int {
 	return fresh
 }
 
-func second(ranges []Range) int {
+func second(ctx context.Context, ranges []Range) int {
+	log := logging.FromContext(ctx)
 	var sum int
 	for _, r := range AggregateRanges(ranges) {
-		fmt.Printf("range: %s\n", r)
+		log.Debug("merged range", "range", r)
 		sum += r.End - r.Start + 1
 	}
 	return sum
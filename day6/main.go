@@ -1,9 +1,11 @@
 package day6
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
+	"github.com/mbark/aoc2025/registry"
 	"github.com/mbark/aoc2025/util"
 )
 
@@ -14,42 +16,66 @@ var testInput = `
 *   +   *   +  
 `
 
-func Run(input string, isTest bool) {
-	if isTest {
-		input = testInput
+func init() {
+	registry.Register(registry.Solver{
+		Name: "Trash Compactor",
+		Year: 2025,
+		Day:  6,
+		Examples: []registry.Example{
+			{
+				Name:    "example",
+				Input:   testInput,
+				Answers: map[int]registry.Answer{1: registry.Int(4277556), 2: registry.Int(3263827)},
+			},
+		},
+		Parts: []registry.Part{part1, part2},
+	})
+}
+
+func part1(_ context.Context, input string, _ registry.Params) (registry.Answer, error) {
+	_, grid, operands, err := parse(input)
+	if err != nil {
+		return registry.Answer{}, err
+	}
+
+	return registry.Int(first(grid, operands)), nil
+}
+
+func part2(_ context.Context, input string, _ registry.Params) (registry.Answer, error) {
+	lines, _, operands, err := parse(input)
+	if err != nil {
+		return registry.Answer{}, err
+	}
+
+	sum, err := second(lines, operands)
+	if err != nil {
+		return registry.Answer{}, err
 	}
 
-	var grid [][]int
+	return registry.Int(sum), nil
+}
+
+func parse(input string) ([]string, [][]int, []rune, error) {
 	var operands []rune
 
 	readInput := util.ReadInput(input, "\n")
-	for i, line := range readInput {
-		if len(readInput)-1 == i {
-			for _, s := range strings.Split(line, " ") {
-				if len(s) == 0 {
-					continue
-				}
-				if len(s) > 1 {
-					panic(fmt.Sprintf("invalid input: %s", line))
-				}
-				operands = append(operands, rune(s[0]))
-			}
+	lines, last := readInput[:len(readInput)-1], readInput[len(readInput)-1]
+	for _, s := range strings.Split(last, " ") {
+		if len(s) == 0 {
 			continue
 		}
-
-		var gridLine []int
-		s := strings.Split(line, " ")
-		for _, v := range s {
-			if len(v) == 0 {
-				continue
-			}
-			gridLine = append(gridLine, util.Str2Int(v))
+		if len(s) > 1 {
+			return nil, nil, nil, fmt.Errorf("invalid input: %s", last)
 		}
-		grid = append(grid, gridLine)
+		operands = append(operands, rune(s[0]))
+	}
+
+	grid, err := util.Strict.NumberLines(strings.Join(lines, "\n"), " ")
+	if err != nil {
+		return nil, nil, nil, err
 	}
 
-	fmt.Printf("first: %d\n", first(grid, operands))
-	fmt.Printf("second: %d\n", second(readInput[:len(readInput)-1], operands))
+	return lines, grid, operands, nil
 }
 
 func first(grid [][]int, operands []rune) int {
@@ -70,7 +96,7 @@ func first(grid [][]int, operands []rune) int {
 	return totalSum
 }
 
-func second(in []string, operands []rune) int {
+func second(in []string, operands []rune) (int, error) {
 	var problems [][]int
 	var problem []int
 
@@ -88,7 +114,11 @@ func second(in []string, operands []rune) int {
 			problem = nil
 			continue
 		}
-		problem = append(problem, util.Str2Int(string(numString)))
+		n, err := util.Strict.Str2Int(string(numString))
+		if err != nil {
+			return 0, fmt.Errorf("column %d: %w", j+1, err)
+		}
+		problem = append(problem, n)
 	}
 	if len(problem) > 0 {
 		problems = append(problems, problem)
@@ -108,5 +138,5 @@ func second(in []string, operands []rune) int {
 		}
 		total += sum
 	}
-	return total
+	return total, nil
 }
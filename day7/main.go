@@ -1,10 +1,11 @@
 package day7
 
 import (
-	"fmt"
+	"context"
 
 	"github.com/mbark/aoc2025/fns"
 	"github.com/mbark/aoc2025/maps"
+	"github.com/mbark/aoc2025/registry"
 )
 
 var testInput = `
@@ -26,11 +27,31 @@ var testInput = `
 ...............
 `
 
-func Run(input string, isTest bool) {
-	if isTest {
-		input = testInput
-	}
+func init() {
+	registry.Register(registry.Solver{
+		Name: "Laboratories",
+		Year: 2025,
+		Day:  7,
+		Examples: []registry.Example{
+			{
+				Name:    "example",
+				Input:   testInput,
+				Answers: map[int]registry.Answer{1: registry.Int(21), 2: registry.Int(40)},
+			},
+		},
+		Parts: []registry.Part{part1, part2},
+	})
+}
+
+func part1(_ context.Context, input string, _ registry.Params) (registry.Answer, error) {
+	return registry.Int(first(parse(input))), nil
+}
+
+func part2(_ context.Context, input string, _ registry.Params) (registry.Answer, error) {
+	return registry.Int(second(parse(input))), nil
+}
 
+func parse(input string) (maps.Map[byte], maps.Coordinate) {
 	var start maps.Coordinate
 	m := maps.New(input, func(x, y int, b byte) byte {
 		if b == 'S' {
@@ -39,8 +60,7 @@ func Run(input string, isTest bool) {
 		return b
 	})
 
-	fmt.Printf("first %d\n", first(m, start))
-	fmt.Printf("second %d\n", second(m, start))
+	return m, start
 }
 
 func first(m maps.Map[byte], start maps.Coordinate) int {
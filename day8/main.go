@@ -1,11 +1,12 @@
 package day8
 
 import (
-	"fmt"
+	"context"
 	"math"
 	"slices"
 
 	"github.com/mbark/aoc2025/maps"
+	"github.com/mbark/aoc2025/registry"
 	"github.com/mbark/aoc2025/util"
 )
 
@@ -32,20 +33,40 @@ var testInput = `
 425,690,689
 `
 
-func Run(input string, isTest bool) {
-	connections := 1000
-	if isTest {
-		input = testInput
-		connections = 10
-	}
+func init() {
+	registry.Register(registry.Solver{
+		Name: "Playground",
+		Year: 2025,
+		Day:  8,
+		Examples: []registry.Example{
+			{
+				Name:    "example",
+				Input:   testInput,
+				Answers: map[int]registry.Answer{1: registry.Int(40), 2: registry.Int(25272)},
+			},
+		},
+		Params: []registry.Param{
+			{Name: "connections", Usage: "number of closest pairs to connect", Default: 1000, Example: 10},
+		},
+		Parts: []registry.Part{part1, part2},
+	})
+}
+
+func part1(_ context.Context, input string, params registry.Params) (registry.Answer, error) {
+	return registry.Int(first(parse(input), params.Int("connections"))), nil
+}
+
+func part2(_ context.Context, input string, _ registry.Params) (registry.Answer, error) {
+	return registry.Int(second(parse(input))), nil
+}
 
+func parse(input string) []maps.Coordinate3D {
 	var boxes []maps.Coordinate3D
 	for _, l := range util.ReadInput(input, "\n") {
 		boxes = append(boxes, maps.NewCoordinate3D(l))
 	}
 
-	fmt.Printf("first: %d\n", first(boxes, connections))
-	fmt.Printf("second: %d\n", second(boxes))
+	return boxes
 }
 
 type distance struct {
@@ -1,11 +1,12 @@
 package day9
 
 import (
-	"fmt"
+	"context"
 	"slices"
 
 	"github.com/mbark/aoc2025/maps"
 	"github.com/mbark/aoc2025/maths"
+	"github.com/mbark/aoc2025/registry"
 	"github.com/mbark/aoc2025/util"
 )
 
@@ -20,18 +21,37 @@ var testInput = `
 7,3
 `
 
-func Run(input string, isTest bool) {
-	if isTest {
-		input = testInput
-	}
+func init() {
+	registry.Register(registry.Solver{
+		Name: "Movie Theater",
+		Year: 2025,
+		Day:  9,
+		Examples: []registry.Example{
+			{
+				Name:    "example",
+				Input:   testInput,
+				Answers: map[int]registry.Answer{1: registry.Int(50), 2: registry.Int(24)},
+			},
+		},
+		Parts: []registry.Part{part1, part2},
+	})
+}
+
+func part1(_ context.Context, input string, _ registry.Params) (registry.Answer, error) {
+	return registry.Int(first(parse(input))), nil
+}
+
+func part2(_ context.Context, input string, _ registry.Params) (registry.Answer, error) {
+	return registry.Int(second(parse(input))), nil
+}
 
+func parse(input string) []maps.Coordinate {
 	var coords []maps.Coordinate
 	for _, l := range util.ReadInput(input, "\n") {
 		coords = append(coords, maps.CoordinateFromString(l))
 	}
 
-	fmt.Printf("first: %d\n", first(coords))
-	fmt.Printf("second: %d\n", second(coords))
+	return coords
 }
 
 func first(coords []maps.Coordinate) int {
@@ -0,0 +1,17 @@
+// Package days imports every day's package so that each registers its solver.
+package days
+
+import (
+	_ "github.com/mbark/aoc2025/day1"
+	_ "github.com/mbark/aoc2025/day10"
+	_ "github.com/mbark/aoc2025/day11"
+	_ "github.com/mbark/aoc2025/day12"
+	_ "github.com/mbark/aoc2025/day2"
+	_ "github.com/mbark/aoc2025/day3"
+	_ "github.com/mbark/aoc2025/day4"
+	_ "github.com/mbark/aoc2025/day5"
+	_ "github.com/mbark/aoc2025/day6"
+	_ "github.com/mbark/aoc2025/day7"
+	_ "github.com/mbark/aoc2025/day8"
+	_ "github.com/mbark/aoc2025/day9"
+)
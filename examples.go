@@ -0,0 +1,50 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/mbark/aoc2025/registry"
+)
+
+// examplesCmd lists the code blocks and answers in the puzzle description as
+// candidates for the day's examples, noting which are already declared.
+func examplesCmd(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("examples", flag.ExitOnError)
+	var (
+		flagYear = yearFlag(fs)
+		flagDay  = fs.Int("day", 0, "day to find examples for")
+		flagHTML = fs.String("html", "", "read the puzzle page from a saved file instead of downloading it")
+	)
+	setupCache := cacheFlags(fs)
+	_ = fs.Parse(args)
+	if err := setupCache(); err != nil {
+		return err
+	}
+
+	puzzle, err := loadPuzzle(ctx, *flagYear, *flagDay, *flagHTML)
+	if err != nil {
+		return err
+	}
+	solver, _ := registry.Get(*flagYear, *flagDay)
+
+	for i, part := range puzzle.Parts {
+		fmt.Printf("part %d, answer %q\n", i+1, part.Answer)
+		for j, e := range part.Examples {
+			declared := "not declared"
+			for _, ex := range solver.Examples {
+				if strings.TrimSpace(ex.Input) == strings.TrimSpace(e) {
+					declared = fmt.Sprintf("declared as %q", ex.Name)
+				}
+			}
+
+			fmt.Printf("--- block %d, %s\n%s", j+1, declared, e)
+			if !strings.HasSuffix(e, "\n") {
+				fmt.Println()
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,186 @@
+// Package golden checks that solvers keep giving the same answers, it is used
+// from each day's tests.
+package golden
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mbark/aoc2025/aoc"
+	"github.com/mbark/aoc2025/registry"
+	"github.com/mbark/aoc2025/runner"
+	"github.com/mbark/aoc2025/util"
+)
+
+// AnswersFile is where the answers for the real input are stored, relative to
+// the day's package. It has the answer for each part on its own line.
+const AnswersFile = "testdata/answers.txt"
+
+var update = flag.Bool("update", false, "write the answers for the real input to "+AnswersFile)
+
+// Answers are the expected answers for a day's real input, the answers for the
+// examples are declared with the solver.
+type Answers struct {
+	// Input is the expected answers for the real input. Parts without an
+	// answer use the one in AnswersFile, or else the correct answer recorded
+	// when submitting.
+	Input registry.Answers
+	// Skip maps parts that can't be checked to the reason why, e.g. because
+	// they need external tools.
+	Skip map[int]string
+}
+
+// Test runs the day's solver on each example and on the cached real input and
+// compares the answers with the expected ones. The real input is skipped if it
+// hasn't been downloaded. With -update the answers for the real input are
+// written to AnswersFile instead of being checked.
+func Test(t *testing.T, year, day int, expected Answers) {
+	t.Helper()
+
+	s, ok := registry.Get(year, day)
+	if !ok {
+		t.Fatalf("no solver registered for %d day %d", year, day)
+	}
+
+	for _, e := range s.Examples {
+		t.Run(e.Name, func(t *testing.T) {
+			check(t, runner.RunExample(t.Context(), s, e, runner.Options{}), expected.Skip)
+		})
+	}
+
+	t.Run("input", func(t *testing.T) {
+		cache := util.InputCache{Dir: cacheDir(t), Mode: util.CacheOffline}
+		input, ok, err := cache.Read(year, day)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !ok {
+			t.Skipf("no cached input in %s", cache.Dir)
+		}
+
+		history, err := aoc.LoadHistory(cache.Dir, year, day)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		results := runner.Run(t.Context(), s, input, runner.Options{})
+		if *update {
+			writeAnswers(t, results, expected.Skip)
+			return
+		}
+
+		want := make(registry.Answers, len(s.Parts))
+		copy(want, expected.Input)
+		for i, a := range readAnswers(t) {
+			if i < len(want) && want[i].IsZero() {
+				want[i] = a
+			}
+		}
+		for _, sub := range history.Submissions {
+			if sub.Outcome != aoc.Correct || sub.Part < 1 || sub.Part > len(want) {
+				continue
+			}
+			if want[sub.Part-1].IsZero() {
+				want[sub.Part-1] = registry.String(sub.Answer)
+			}
+		}
+
+		for i := range results {
+			results[i].Expected = want[i]
+		}
+		check(t, results, expected.Skip)
+	})
+}
+
+func check(t *testing.T, results []runner.Result, skip map[int]string) {
+	for _, r := range results {
+		t.Run(fmt.Sprintf("part%d", r.Part), func(t *testing.T) {
+			if reason, ok := skip[r.Part]; ok {
+				t.Skip(reason)
+			}
+			if r.Err != nil {
+				t.Fatal(r.Err)
+			}
+			if r.Expected.IsZero() {
+				t.Skip("no expected answer")
+			}
+			if r.IsWrong() {
+				t.Errorf("got %s, want %s", r.Answer, r.Expected)
+			}
+		})
+	}
+}
+
+// readAnswers reads the answers in AnswersFile, a part with an empty line has
+// no answer.
+func readAnswers(t *testing.T) registry.Answers {
+	b, err := os.ReadFile(AnswersFile)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var answers registry.Answers
+	for _, line := range strings.Split(strings.TrimSuffix(string(b), "\n"), "\n") {
+		var a registry.Answer
+		if line = strings.TrimSpace(line); line != "" {
+			a = registry.String(line)
+		}
+		answers = append(answers, a)
+	}
+	return answers
+}
+
+func writeAnswers(t *testing.T, results []runner.Result, skip map[int]string) {
+	var sb strings.Builder
+	for _, r := range results {
+		if _, ok := skip[r.Part]; r.Err != nil && !ok {
+			t.Fatalf("part %d: %s", r.Part, r.Err)
+		}
+		if r.Err == nil {
+			sb.WriteString(r.Answer.String())
+		}
+		sb.WriteString("\n")
+	}
+
+	if err := os.MkdirAll(filepath.Dir(AnswersFile), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(AnswersFile, []byte(sb.String()), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	t.Logf("wrote %s", AnswersFile)
+}
+
+// cacheDir returns the input cache, relative paths are resolved from the
+// module root since tests run in the package's directory.
+func cacheDir(t *testing.T) string {
+	dir := util.DefaultCacheDir()
+	if filepath.IsAbs(dir) {
+		return dir
+	}
+
+	root, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for {
+		if _, err := os.Stat(filepath.Join(root, "go.mod")); err == nil {
+			return filepath.Join(root, dir)
+		}
+
+		parent := filepath.Dir(root)
+		if parent == root {
+			return dir
+		}
+		root = parent
+	}
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/mbark/aoc2025/aoc"
+	"github.com/mbark/aoc2025/util"
+)
+
+// leaderboard prints the rankings of a private leaderboard, followed by when
+// each member got their stars for each day.
+func leaderboard(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("leaderboard", flag.ExitOnError)
+	var (
+		flagYear = yearFlag(fs)
+		flagID   = fs.String("id", os.Getenv("AOC_LEADERBOARD"), "id of the private leaderboard, defaults to $AOC_LEADERBOARD")
+		flagDay  = fs.Int("day", 0, "only show the star times for this day")
+		flagJSON = fs.String("json", "", "read the leaderboard from a saved file instead of downloading it")
+	)
+	setupCache := cacheFlags(fs)
+	_ = fs.Parse(args)
+	if err := setupCache(); err != nil {
+		return err
+	}
+
+	var l aoc.Leaderboard
+	switch {
+	case *flagJSON != "":
+		b, err := os.ReadFile(*flagJSON)
+		if err != nil {
+			return err
+		}
+		if l, err = aoc.ParseLeaderboard(b); err != nil {
+			return err
+		}
+	case *flagID == "":
+		return fmt.Errorf("-id or $AOC_LEADERBOARD is required")
+	default:
+		var fetched time.Time
+		var err error
+		l, fetched, err = util.GetLeaderboard(ctx, *flagYear, *flagID)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("fetched %s ago\n\n", time.Since(fetched).Round(time.Second))
+	}
+
+	members := l.Ranking()
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "rank\tname\tscore\tstars\t")
+	for i, m := range members {
+		fmt.Fprintf(tw, "%d\t%s\t%d\t%d\t\n", i+1, m.DisplayName(), m.LocalScore, m.Stars)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	days := l.Days()
+	if *flagDay != 0 {
+		days = []int{*flagDay}
+	}
+	for _, day := range days {
+		fmt.Printf("\nday %d\n", day)
+		tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.AlignRight)
+		fmt.Fprintln(tw, "name\tpart 1\tpart 2\tdelta\t")
+		for _, m := range members {
+			if len(m.Days[day]) == 0 {
+				continue
+			}
+
+			first, ok1 := l.Completion(m, day, 1)
+			second, ok2 := l.Completion(m, day, 2)
+			delta, ok3 := m.Delta(day)
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t\n", m.DisplayName(), clock(first, ok1), clock(second, ok2), clock(delta, ok3))
+		}
+		if err := tw.Flush(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// clock formats the duration as hours, minutes and seconds, or - if ok is
+// false.
+func clock(d time.Duration, ok bool) string {
+	if !ok {
+		return "-"
+	}
+
+	s := int(d.Round(time.Second).Seconds())
+	return fmt.Sprintf("%d:%02d:%02d", s/3600, s/60%60, s%60)
+}
@@ -0,0 +1,75 @@
+// Package logging gives solvers a levelled logger through their context.
+//
+// Logging that is disabled only costs checking the level, but looking up the
+// logger walks the context so in hot loops get it once with FromContext or
+// guard the call with Enabled.
+package logging
+
+import (
+	"context"
+	"io"
+	"log/slog"
+)
+
+// LevelTrace is more verbose than debug, for output inside loops.
+const LevelTrace = slog.LevelDebug - 4
+
+// Off is a level above any used so that nothing is logged.
+const Off = slog.LevelError + 4
+
+type key struct{}
+
+var discard = slog.New(slog.DiscardHandler)
+
+// New returns a logger writing messages at level or above to w.
+func New(w io.Writer, level slog.Level) *slog.Logger {
+	return slog.New(slog.NewTextHandler(w, &slog.HandlerOptions{
+		Level: level,
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if len(groups) == 0 && a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			if len(groups) == 0 && a.Key == slog.LevelKey && a.Value.Any() == LevelTrace {
+				return slog.String(slog.LevelKey, "TRACE")
+			}
+			return a
+		},
+	}))
+}
+
+// Verbosity returns the level for the number of -v flags given.
+func Verbosity(v int) slog.Level {
+	switch {
+	case v >= 2:
+		return LevelTrace
+	case v == 1:
+		return slog.LevelDebug
+	default:
+		return Off
+	}
+}
+
+func WithLogger(ctx context.Context, l *slog.Logger) context.Context {
+	return context.WithValue(ctx, key{}, l)
+}
+
+// FromContext returns the context's logger, or one that discards everything
+// if there is none.
+func FromContext(ctx context.Context) *slog.Logger {
+	if l, ok := ctx.Value(key{}).(*slog.Logger); ok {
+		return l
+	}
+	return discard
+}
+
+func Enabled(ctx context.Context, level slog.Level) bool {
+	return FromContext(ctx).Enabled(ctx, level)
+}
+
+func Debug(ctx context.Context, msg string, args ...any) {
+	FromContext(ctx).DebugContext(ctx, msg, args...)
+}
+
+func Trace(ctx context.Context, msg string, args ...any) {
+	FromContext(ctx).Log(ctx, LevelTrace, msg, args...)
+}
@@ -0,0 +1,32 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestLevels(t *testing.T) {
+	for v, want := range []string{"", "level=DEBUG msg=debug", "level=DEBUG msg=debug\nlevel=TRACE msg=trace"} {
+		var buf bytes.Buffer
+		ctx := WithLogger(context.Background(), New(&buf, Verbosity(v)))
+		Debug(ctx, "debug")
+		Trace(ctx, "trace")
+
+		if got := strings.TrimSpace(buf.String()); got != want {
+			t.Errorf("-v %d: got %q, want %q", v, got, want)
+		}
+	}
+}
+
+func TestDisabledDoesNotAllocate(t *testing.T) {
+	ctx := context.Background()
+	log := FromContext(ctx)
+	allocs := testing.AllocsPerRun(100, func() {
+		log.Debug("disabled")
+	})
+	if allocs != 0 {
+		t.Errorf("got %v allocs, want 0", allocs)
+	}
+}
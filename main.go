@@ -1,71 +1,386 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
+	"io"
 	"os"
+	"os/signal"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
 
-	"github.com/mbark/aoc2025/day1"
-	"github.com/mbark/aoc2025/day10"
-	"github.com/mbark/aoc2025/day11"
-	"github.com/mbark/aoc2025/day12"
-	"github.com/mbark/aoc2025/day2"
-	"github.com/mbark/aoc2025/day3"
-	"github.com/mbark/aoc2025/day4"
-	"github.com/mbark/aoc2025/day5"
-	"github.com/mbark/aoc2025/day6"
-	"github.com/mbark/aoc2025/day7"
-	"github.com/mbark/aoc2025/day8"
-	"github.com/mbark/aoc2025/day9"
+	_ "github.com/mbark/aoc2025/days"
+	"github.com/mbark/aoc2025/logging"
+	"github.com/mbark/aoc2025/profile"
+	"github.com/mbark/aoc2025/registry"
+	"github.com/mbark/aoc2025/runner"
 	"github.com/mbark/aoc2025/util"
 )
 
+// moduleYear is the year of the days in the root of the module, days of other
+// years live in a directory named after their year.
+const moduleYear = 2025
+
 func main() {
+	cmd, args := "run", os.Args[1:]
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		cmd, args = args[0], args[1:]
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	// the first Ctrl-C cancels the context, a second one kills the program
+	// in case something doesn't stop
+	context.AfterFunc(ctx, stop)
+
+	var err error
+	switch cmd {
+	case "run":
+		err = run(ctx, args)
+	case "all":
+		err = all(ctx, args)
+	case "bench":
+		err = bench(ctx, args)
+	case "examples":
+		err = examplesCmd(ctx, args)
+	case "leaderboard":
+		err = leaderboard(ctx, args)
+	case "list":
+		err = list(args)
+	case "new":
+		err = newDay(ctx, args)
+	case "submit":
+		err = submit(ctx, args)
+	case "watch":
+		err = watch(ctx, args)
+	default:
+		err = fmt.Errorf("unknown command %q", cmd)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		stop()
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("run", flag.ExitOnError)
 	var (
-		flagDay    = flag.Int("day", 0, "use test input")
-		flagTest   = flag.Bool("test", false, "use test input")
-		cpuprofile = flag.Bool("profile", false, "write cpu profile to file")
+		flagYear    = yearFlag(fs)
+		flagDay     = fs.Int("day", 0, "day to run")
+		flagTest    = fs.Bool("test", false, "run the examples instead of the input")
+		flagExample = fs.String("example", "", "only run the example with this name, implies -test")
+		flagInput   = fs.String("input", "", "read the input from a file, or stdin if -, instead of the cache")
+		format      = fs.String("format", "text", "output format: text or json")
+		params      paramFlag
 	)
-	flag.Parse()
+	fs.Var(&params, "param", "override a puzzle parameter, as name=value")
+	setupCache := cacheFlags(fs)
+	options := runFlags(fs)
+	profiling := profileFlags(fs)
+	_ = fs.Parse(args)
+	if err := setupCache(); err != nil {
+		return err
+	}
+	opts := options()
+	prof, err := profiling()
+	if err != nil {
+		return err
+	}
 
-	if *cpuprofile {
-		fmt.Println("using cpu profile")
-		fn := util.WithProfiling()
-		defer fn()
+	solver, ok := registry.Get(*flagYear, *flagDay)
+	if !ok {
+		return fmt.Errorf("%d day %d not implemented", *flagYear, *flagDay)
+	}
+	if err := checkParts(opts, solver); err != nil {
+		return err
+	}
+	if *flagInput != "" && (*flagTest || *flagExample != "") {
+		return fmt.Errorf("-input can't be combined with -test or -example")
 	}
+	if *format != "text" && *format != "json" {
+		return fmt.Errorf("unknown format %q", *format)
+	}
+	overrides, err := solver.ParseParams(params)
+	if err != nil {
+		return err
+	}
+	opts.Overrides = overrides
 
+	test := *flagTest || *flagExample != ""
+	var exs []registry.Example
 	var input string
-	if !*flagTest {
-		input = util.GetInput(*flagDay)
-	}
-
-	switch *flagDay {
-	case 1:
-		day1.Run(input, *flagTest)
-	case 2:
-		day2.Run(input, *flagTest)
-	case 3:
-		day3.Run(input, *flagTest)
-	case 4:
-		day4.Run(input, *flagTest)
-	case 5:
-		day5.Run(input, *flagTest)
-	case 6:
-		day6.Run(input, *flagTest)
-	case 7:
-		day7.Run(input, *flagTest)
-	case 8:
-		day8.Run(input, *flagTest)
-	case 9:
-		day9.Run(input, *flagTest)
-	case 10:
-		day10.Run(input, *flagTest)
-	case 11:
-		day11.Run(input, *flagTest)
-	case 12:
-		day12.Run(input, *flagTest)
+	if test {
+		exs, err = examples(solver, *flagExample)
+	} else {
+		input, err = readInput(*flagYear, *flagDay, *flagInput)
+	}
+	if err != nil {
+		return err
+	}
+
+	var results []runner.Result
+	err = prof.run(ctx, prof.path, func() {
+		if !test {
+			results = runner.Run(ctx, solver, input, opts)
+			return
+		}
+		for _, e := range exs {
+			results = append(results, runner.RunExample(ctx, solver, e, opts)...)
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	if *format == "json" {
+		if err := runner.WriteJSON(os.Stdout, results); err != nil {
+			return err
+		}
+		return failures(results)
+	}
+
+	for _, r := range results {
+		name := runner.InputName(r.Example)
+
+		switch r.Status() {
+		case "timeout":
+			fmt.Printf("%s part %d: timeout\n", name, r.Part)
+		case "error":
+			fmt.Printf("%s part %d: error: %s\n", name, r.Part, r.Err)
+		case "wrong":
+			fmt.Printf("%s part %d: %s, want %s\n", name, r.Part, r.Answer, r.Expected)
+		default:
+			fmt.Printf("%s part %d: %s\n", name, r.Part, r.Answer)
+		}
+	}
+	return failures(results)
+}
+
+// failures returns an error counting the results that aren't ok, if any.
+func failures(results []runner.Result) error {
+	var failed int
+	for _, r := range results {
+		if r.Status() != "ok" {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d parts failed", failed, len(results))
+	}
+	return nil
+}
+
+// runFlags adds the flags selecting which parts to run, for how long and how
+// verbosely, the returned function gives the options once the flags have been
+// parsed.
+func runFlags(fs *flag.FlagSet) func() runner.Options {
+	var (
+		part    = fs.Int("part", 0, "only run this part")
+		timeout = fs.Duration("timeout", 0, "cancel a part that runs for longer than this")
+		v       = fs.Bool("v", false, "log debug output from the solvers to stderr")
+		vv      = fs.Bool("vv", false, "log debug and trace output from the solvers to stderr")
+	)
+
+	return func() runner.Options {
+		opts := runner.Options{Timeout: *timeout}
+		switch {
+		case *vv:
+			opts.Logger = logging.New(os.Stderr, logging.Verbosity(2))
+		case *v:
+			opts.Logger = logging.New(os.Stderr, logging.Verbosity(1))
+		}
+		if *part != 0 {
+			opts.Parts = []int{*part}
+		}
+		return opts
+	}
+}
+
+// checkParts returns an error if a selected part is below 1 or isn't one of
+// the parts of any of the solvers, days with fewer parts just skip it.
+func checkParts(opts runner.Options, solvers ...registry.Solver) error {
+	for _, p := range opts.Parts {
+		if p >= 1 && slices.ContainsFunc(solvers, func(s registry.Solver) bool { return p <= len(s.Parts) }) {
+			continue
+		}
+		if len(solvers) == 1 {
+			return fmt.Errorf("%d day %d has no part %d", solvers[0].Year, solvers[0].Day, p)
+		}
+		return fmt.Errorf("no day has part %d", p)
+	}
+	return nil
+}
+
+// profiler is the profile to take while running the solvers, if any.
+type profiler struct {
+	kind profile.Kind
+	path string
+	top  int
+}
+
+// profileFlags adds the flags for profiling, the returned function gives the
+// profile to take once the flags have been parsed.
+func profileFlags(fs *flag.FlagSet) func() (profiler, error) {
+	var (
+		kind = fs.String("profile", "", "profile while running: cpu, mem, block, mutex or trace")
+		path = fs.String("profile-out", "", "file to write the profile to, defaults to cpu.pprof, mem.pprof etc.")
+		top  = fs.Int("profile-top", 0, "print this many of the top functions in the profile")
+	)
+
+	return func() (profiler, error) {
+		if *kind == "" {
+			if *path != "" || *top != 0 {
+				return profiler{}, fmt.Errorf("-profile-out and -profile-top require -profile")
+			}
+			return profiler{}, nil
+		}
+
+		k, err := profile.ParseKind(*kind)
+		if err != nil {
+			return profiler{}, err
+		}
+		if k == profile.Trace && *top != 0 {
+			return profiler{}, fmt.Errorf("-profile-top can't summarize a trace")
+		}
+		p := profiler{kind: k, path: *path, top: *top}
+		if p.path == "" {
+			p.path = k.DefaultPath()
+		}
+		return p, nil
+	}
+}
+
+// run runs f while writing the profile to path, and then prints a summary of
+// it if asked to. f is run as is if there is no profile to take.
+func (p profiler) run(ctx context.Context, path string, f func()) error {
+	if p.kind == "" {
+		f()
+		return nil
+	}
+
+	prof, err := profile.Start(p.kind, path)
+	if err != nil {
+		return err
+	}
+	f()
+	if err := prof.Stop(); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "wrote %s profile to %s\n", p.kind, path)
+	if p.top > 0 {
+		return prof.Top(ctx, os.Stderr, p.top)
+	}
+	return nil
+}
+
+// readInput reads the input from the path, or stdin if it is -, and gets the
+// day's input from the cache or website if it isn't set.
+func readInput(year, day int, path string) (string, error) {
+	var b []byte
+	var err error
+	switch path {
+	case "":
+		return util.GetInput(year, day)
+	case "-":
+		b, err = io.ReadAll(os.Stdin)
 	default:
-		fmt.Println("not implemented")
-		os.Exit(1)
+		b, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return "", fmt.Errorf("read input: %w", err)
+	}
+
+	return string(b), nil
+}
+
+// examples returns the solver's examples, or only the one with the given name
+// if it is set.
+func examples(s registry.Solver, name string) ([]registry.Example, error) {
+	if name == "" {
+		return s.Examples, nil
+	}
+
+	e, ok := s.Example(name)
+	if !ok {
+		return nil, fmt.Errorf("day %d has no example %q", s.Day, name)
 	}
+	return []registry.Example{e}, nil
+}
+
+func list(args []string) error {
+	fs := flag.NewFlagSet("list", flag.ExitOnError)
+	flagYear := fs.Int("year", 0, "only list the solvers for this year")
+	_ = fs.Parse(args)
+
+	for _, s := range registry.All() {
+		if *flagYear != 0 && s.Year != *flagYear {
+			continue
+		}
+		fmt.Println(s)
+		for _, p := range s.Params {
+			example := p.Example
+			if example == nil {
+				example = p.Default
+			}
+			fmt.Printf("  -param %s=%v (%v for examples): %s\n", p.Name, p.Default, example, p.Usage)
+		}
+	}
+	return nil
+}
+
+// yearFlag adds the -year flag, it defaults to $AOC_YEAR if it is set.
+func yearFlag(fs *flag.FlagSet) *int {
+	year := moduleYear
+	if y, err := strconv.Atoi(os.Getenv("AOC_YEAR")); err == nil {
+		year = y
+	}
+	return fs.Int("year", year, "year of the puzzle")
+}
+
+// dayDir is the directory of the day's package relative to the module root.
+func dayDir(year, day int) string {
+	if year == moduleYear {
+		return fmt.Sprintf("day%d", day)
+	}
+	return filepath.Join(strconv.Itoa(year), fmt.Sprintf("day%d", day))
+}
+
+// cacheFlags adds the flags controlling the input cache, the returned
+// function applies them once the flags have been parsed.
+func cacheFlags(fs *flag.FlagSet) func() error {
+	var (
+		dir     = fs.String("cache", util.DefaultCacheDir(), "directory to cache inputs in")
+		offline = fs.Bool("offline", false, "only use cached inputs")
+		refresh = fs.Bool("refresh", false, "download the input even if it is cached")
+	)
+
+	return func() error {
+		util.Cache.Dir = *dir
+		switch {
+		case *offline && *refresh:
+			return fmt.Errorf("-offline and -refresh are mutually exclusive")
+		case *offline:
+			util.Cache.Mode = util.CacheOffline
+		case *refresh:
+			util.Cache.Mode = util.CacheRefresh
+		}
+		return nil
+	}
+}
+
+// paramFlag collects every -param flag.
+type paramFlag []string
+
+func (p *paramFlag) String() string {
+	return strings.Join(*p, ",")
+}
+
+func (p *paramFlag) Set(v string) error {
+	*p = append(*p, v)
+	return nil
 }
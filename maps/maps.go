@@ -309,7 +309,7 @@ func (m Map[T]) String() string {
 			case int:
 				sb.WriteString(strconv.Itoa(t))
 			default:
-				sb.WriteString(fmt.Sprintf("%s", cell))
+				sb.WriteString(fmt.Sprintf("%v", cell))
 			}
 		}
 		sb.WriteString("\n")
@@ -333,3 +333,16 @@ func (m Map[T]) Stringf(sprintf func(c Coordinate, val T) string) string {
 func (m Map[T]) Length() int {
 	return m.Rows * m.Columns
 }
+
+// FromSection parses the section as a map like New, but errors if its rows
+// aren't all the same length.
+func FromSection[T any](s util.Section, fn func(x, y int, b byte) T) (Map[T], error) {
+	lines := s.Lines()
+	for i, l := range lines {
+		if len(l) != len(lines[0]) {
+			return Map[T]{}, fmt.Errorf("line %d: row is %d long, want %d", s.Line+i, len(l), len(lines[0]))
+		}
+	}
+
+	return New(s.Text, fn), nil
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/mbark/aoc2025/aoc"
+	"github.com/mbark/aoc2025/registry"
+	"github.com/mbark/aoc2025/scaffold"
+	"github.com/mbark/aoc2025/util"
+)
+
+const module = "github.com/mbark/aoc2025"
+
+func newDay(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("new", flag.ExitOnError)
+	var (
+		flagYear = yearFlag(fs)
+		flagDay  = fs.Int("day", 0, "day to create")
+		flagName = fs.String("name", "", "name of the puzzle, taken from the puzzle page if empty")
+		flagHTML = fs.String("html", "", "read the puzzle page from a saved file instead of downloading it")
+	)
+	setupCache := cacheFlags(fs)
+	_ = fs.Parse(args)
+	if err := setupCache(); err != nil {
+		return err
+	}
+
+	if *flagDay < 1 || *flagDay > 25 {
+		return fmt.Errorf("invalid day %d", *flagDay)
+	}
+	if _, ok := registry.Get(*flagYear, *flagDay); ok {
+		return fmt.Errorf("%d day %d already exists", *flagYear, *flagDay)
+	}
+
+	d := scaffold.Day{Module: module, Dir: dayDir(*flagYear, *flagDay), Year: *flagYear, Day: *flagDay, Name: *flagName}
+	if puzzle, err := loadPuzzle(ctx, *flagYear, *flagDay, *flagHTML); err != nil {
+		fmt.Printf("no example found: %s\n", err)
+	} else {
+		d.Example, d.Answers = puzzle.Example()
+		if d.Name == "" {
+			d.Name = puzzle.Title
+		}
+	}
+
+	if err := scaffold.Create(".", d); err != nil {
+		return err
+	}
+	fmt.Printf("created %s\n", d.Dir)
+
+	if util.Cache.Mode == util.CacheOffline {
+		fmt.Println("offline, skipped downloading the input")
+		return nil
+	}
+	if _, err := util.GetInput(*flagYear, *flagDay); err != nil {
+		return fmt.Errorf("download input: %w", err)
+	}
+	fmt.Printf("downloaded input to %s\n", util.Cache.Path(*flagYear, *flagDay))
+	return nil
+}
+
+// loadPuzzle reads the puzzle page from path if it is set and downloads it
+// otherwise.
+func loadPuzzle(ctx context.Context, year, day int, path string) (aoc.Puzzle, error) {
+	if path != "" {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return aoc.Puzzle{}, err
+		}
+		return aoc.ParsePuzzle(string(b)), nil
+	}
+
+	if util.Cache.Mode == util.CacheOffline {
+		return aoc.Puzzle{}, fmt.Errorf("offline")
+	}
+	return util.Client.Puzzle(ctx, year, day)
+}
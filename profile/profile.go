@@ -0,0 +1,185 @@
+// Package profile takes cpu, memory, blocking, mutex and execution trace
+// profiles.
+package profile
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"runtime/pprof"
+	"runtime/trace"
+	"strconv"
+	"strings"
+)
+
+type Kind string
+
+const (
+	CPU   Kind = "cpu"
+	Mem   Kind = "mem"
+	Block Kind = "block"
+	Mutex Kind = "mutex"
+	Trace Kind = "trace"
+)
+
+var kinds = []Kind{CPU, Mem, Block, Mutex, Trace}
+
+func ParseKind(s string) (Kind, error) {
+	for _, k := range kinds {
+		if string(k) == s {
+			return k, nil
+		}
+	}
+	return "", fmt.Errorf("unknown profile %q, want one of cpu, mem, block, mutex or trace", s)
+}
+
+// DefaultPath is where the profile is written if no path is given.
+func (k Kind) DefaultPath() string {
+	if k == Trace {
+		return "trace.out"
+	}
+	return string(k) + ".pprof"
+}
+
+// Profile is a profile that has been started.
+type Profile struct {
+	Kind Kind
+	Path string
+	f    *os.File
+	// base is the mem, block or mutex profile at Start, which is subtracted
+	// from the one at Stop since the runtime counts from the program start.
+	base []byte
+}
+
+// Start starts profiling, writing the profile to the path once stopped.
+func Start(kind Kind, path string) (*Profile, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, fmt.Errorf("create profile: %w", err)
+	}
+
+	p := &Profile{Kind: kind, Path: path, f: f}
+	switch kind {
+	case CPU:
+		err = pprof.StartCPUProfile(f)
+	case Trace:
+		err = trace.Start(f)
+	case Block:
+		runtime.SetBlockProfileRate(1)
+	case Mutex:
+		runtime.SetMutexProfileFraction(1)
+	}
+	if err == nil && p.cumulative() {
+		p.base, err = p.snapshot()
+	}
+	if err != nil {
+		_ = f.Close()
+		return nil, fmt.Errorf("start %s profile: %w", kind, err)
+	}
+	return p, nil
+}
+
+// cumulative is whether the runtime counts the profile from the program start.
+func (p *Profile) cumulative() bool {
+	return p.Kind == Mem || p.Kind == Block || p.Kind == Mutex
+}
+
+// snapshot returns the mem, block or mutex profile as it is now.
+func (p *Profile) snapshot() ([]byte, error) {
+	name := map[Kind]string{Mem: "allocs", Block: "block", Mutex: "mutex"}[p.Kind]
+	if p.Kind == Mem {
+		// the allocs profile is only updated by a garbage collection
+		runtime.GC()
+	}
+
+	var b bytes.Buffer
+	if err := pprof.Lookup(name).WriteTo(&b, 0); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+// Stop stops profiling and writes the profile.
+func (p *Profile) Stop() error {
+	var err error
+	switch p.Kind {
+	case CPU:
+		pprof.StopCPUProfile()
+	case Trace:
+		trace.Stop()
+	default:
+		var cur []byte
+		if cur, err = p.snapshot(); err == nil {
+			err = subtract(p.f, p.base, cur)
+		}
+	}
+	switch p.Kind {
+	case Block:
+		runtime.SetBlockProfileRate(0)
+	case Mutex:
+		runtime.SetMutexProfileFraction(0)
+	}
+
+	if cerr := p.f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return fmt.Errorf("write %s profile: %w", p.Kind, err)
+	}
+	return nil
+}
+
+// subtract writes the profile cur minus base to w, using go tool pprof.
+func subtract(w io.Writer, base, cur []byte) error {
+	dir, err := os.MkdirTemp("", "profile")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	basePath, curPath := filepath.Join(dir, "base.pprof"), filepath.Join(dir, "cur.pprof")
+	if err := os.WriteFile(basePath, base, 0o600); err != nil {
+		return err
+	}
+	if err := os.WriteFile(curPath, cur, 0o600); err != nil {
+		return err
+	}
+
+	var stderr bytes.Buffer
+	cmd := exec.Command("go", "tool", "pprof", "-proto", "-base", basePath, curPath)
+	cmd.Stdout = w
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("go tool pprof: %w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+	return nil
+}
+
+// Top writes the n functions with the most samples in the profile, using
+// go tool pprof.
+func (p *Profile) Top(ctx context.Context, w io.Writer, n int) error {
+	if p.Kind == Trace {
+		return fmt.Errorf("no summary of an execution trace, open it with go tool trace %s", p.Path)
+	}
+
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, "go", "tool", "pprof", "-top", "-nodecount="+strconv.Itoa(n), p.Path)
+	cmd.Stdout = w
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("go tool pprof: %w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+	return nil
+}
+
+// DayPath adds the day to the path before its extension, so cpu.pprof becomes
+// cpu-day05.pprof.
+func DayPath(path string, day int) string {
+	ext := filepath.Ext(path)
+	return fmt.Sprintf("%s-day%02d%s", strings.TrimSuffix(path, ext), day, ext)
+}
@@ -0,0 +1,64 @@
+package profile
+
+import (
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+var sink [][]byte
+
+//go:noinline
+func allocFirst() {
+	for range 100 {
+		sink = append(sink, make([]byte, 1<<16))
+	}
+}
+
+//go:noinline
+func allocSecond() {
+	for range 100 {
+		sink = append(sink, make([]byte, 1<<16))
+	}
+}
+
+// TestMemPerProfile checks that a mem profile only has the allocations made
+// while it ran, and not those of an earlier profile.
+func TestMemPerProfile(t *testing.T) {
+	if testing.Short() {
+		t.Skip("runs go tool pprof")
+	}
+	defer func(rate int) { runtime.MemProfileRate = rate }(runtime.MemProfileRate)
+	runtime.MemProfileRate = 1
+
+	dir := t.TempDir()
+	top := func(name string, alloc func()) string {
+		p, err := Start(Mem, filepath.Join(dir, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		alloc()
+		if err := p.Stop(); err != nil {
+			t.Fatal(err)
+		}
+
+		var sb strings.Builder
+		if err := p.Top(t.Context(), &sb, 20); err != nil {
+			t.Fatal(err)
+		}
+		return sb.String()
+	}
+
+	if out := top("first.pprof", allocFirst); !strings.Contains(out, "allocFirst") {
+		t.Errorf("first profile is missing allocFirst:\n%s", out)
+	}
+	out := top("second.pprof", allocSecond)
+	if !strings.Contains(out, "allocSecond") {
+		t.Errorf("second profile is missing allocSecond:\n%s", out)
+	}
+	if strings.Contains(out, "allocFirst") {
+		t.Errorf("second profile has the allocations of the first:\n%s", out)
+	}
+	sink = nil
+}
@@ -0,0 +1,58 @@
+package registry
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+)
+
+// Answer is the solution to one part of a puzzle. It is either an int, a
+// string or a big.Int.
+type Answer struct {
+	value any
+}
+
+func Int(i int) Answer {
+	return Answer{value: i}
+}
+
+func String(s string) Answer {
+	return Answer{value: s}
+}
+
+func BigInt(i *big.Int) Answer {
+	return Answer{value: new(big.Int).Set(i)}
+}
+
+// Value returns the underlying int, string or *big.Int.
+func (a Answer) Value() any {
+	return a.value
+}
+
+func (a Answer) IsZero() bool {
+	return a.value == nil
+}
+
+func (a Answer) String() string {
+	switch v := a.value.(type) {
+	case nil:
+		return ""
+	case int:
+		return strconv.Itoa(v)
+	case string:
+		return v
+	case *big.Int:
+		return v.String()
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+// Equal compares answers by their string representation since that is what
+// the puzzle is submitted as.
+func (a Answer) Equal(o Answer) bool {
+	return a.String() == o.String()
+}
+
+// Answers holds the answer to each part of a puzzle, in order.
+type Answers []Answer
@@ -0,0 +1,22 @@
+package registry
+
+import (
+	"maps"
+	"slices"
+)
+
+// Example is an example input from the puzzle description.
+type Example struct {
+	Name  string
+	Input string
+	// Answers holds the expected answer for each part the example applies
+	// to, keyed by part.
+	Answers map[int]Answer
+	// Params overrides the solver's parameters for this example.
+	Params Params
+}
+
+// Parts returns the parts the example applies to in order.
+func (e Example) Parts() []int {
+	return slices.Sorted(maps.Keys(e.Answers))
+}
@@ -0,0 +1,115 @@
+package registry
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Param declares a named value a puzzle depends on that differs between the
+// examples and the real input, e.g. the number of steps to simulate.
+type Param struct {
+	Name  string
+	Usage string
+	// Default is the value for the real input, it decides the type of the
+	// parameter and must be an int, string or bool.
+	Default any
+	// Example is the value for the examples, Default is used if it is nil.
+	Example any
+}
+
+// Params are the values of a solver's parameters, keyed by name.
+type Params map[string]any
+
+func (p Params) Int(name string) int {
+	return get[int](p, name)
+}
+
+func (p Params) String(name string) string {
+	return get[string](p, name)
+}
+
+func (p Params) Bool(name string) bool {
+	return get[bool](p, name)
+}
+
+func get[T any](p Params, name string) T {
+	v, ok := p[name].(T)
+	if !ok {
+		panic(fmt.Sprintf("param %s is %T, not %T", name, p[name], v))
+	}
+	return v
+}
+
+// With returns a copy of the params with the overrides set.
+func (p Params) With(overrides Params) Params {
+	merged := make(Params, len(p)+len(overrides))
+	for k, v := range p {
+		merged[k] = v
+	}
+	for k, v := range overrides {
+		merged[k] = v
+	}
+	return merged
+}
+
+// InputParams returns the parameters for the real input.
+func (s Solver) InputParams() Params {
+	params := make(Params, len(s.Params))
+	for _, p := range s.Params {
+		params[p.Name] = p.Default
+	}
+	return params
+}
+
+// ExampleParams returns the parameters for the example, the example's own
+// params take precedence over the declared example defaults.
+func (s Solver) ExampleParams(e Example) Params {
+	params := make(Params, len(s.Params))
+	for _, p := range s.Params {
+		params[p.Name] = p.Default
+		if p.Example != nil {
+			params[p.Name] = p.Example
+		}
+	}
+	return params.With(e.Params)
+}
+
+// ParseParams parses name=value pairs into params, each value is parsed as the
+// type of the declared parameter.
+func (s Solver) ParseParams(values []string) (Params, error) {
+	params := make(Params, len(values))
+	for _, v := range values {
+		name, value, ok := strings.Cut(v, "=")
+		if !ok {
+			return nil, fmt.Errorf("param %q is not on the form name=value", v)
+		}
+
+		var decl *Param
+		for i := range s.Params {
+			if s.Params[i].Name == name {
+				decl = &s.Params[i]
+			}
+		}
+		if decl == nil {
+			return nil, fmt.Errorf("day %d has no param %s", s.Day, name)
+		}
+
+		var err error
+		switch decl.Default.(type) {
+		case int:
+			params[name], err = strconv.Atoi(value)
+		case bool:
+			params[name], err = strconv.ParseBool(value)
+		case string:
+			params[name] = value
+		default:
+			err = fmt.Errorf("unsupported type %T", decl.Default)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("param %s: %w", name, err)
+		}
+	}
+
+	return params, nil
+}
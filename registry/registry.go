@@ -0,0 +1,74 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+	"slices"
+)
+
+// Part solves one part of a puzzle for the given input. Long running parts
+// should stop when the context is cancelled.
+type Part func(ctx context.Context, input string, params Params) (Answer, error)
+
+// Solver describes the solution to a single day's puzzle.
+type Solver struct {
+	Name     string
+	Year     int
+	Day      int
+	Examples []Example
+	Params   []Param
+	Parts    []Part
+}
+
+func (s Solver) String() string {
+	return fmt.Sprintf("%d day %d: %s", s.Year, s.Day, s.Name)
+}
+
+// Example returns the example with the given name.
+func (s Solver) Example(name string) (Example, bool) {
+	for _, e := range s.Examples {
+		if e.Name == name {
+			return e, true
+		}
+	}
+
+	return Example{}, false
+}
+
+type key struct {
+	year, day int
+}
+
+var solvers = map[key]Solver{}
+
+// Register adds a solver to the registry, it is meant to be called from the
+// init function of each day's package.
+func Register(s Solver) {
+	k := key{year: s.Year, day: s.Day}
+	if _, ok := solvers[k]; ok {
+		panic(fmt.Sprintf("solver already registered for %d day %d", s.Year, s.Day))
+	}
+
+	solvers[k] = s
+}
+
+func Get(year, day int) (Solver, bool) {
+	s, ok := solvers[key{year: year, day: day}]
+	return s, ok
+}
+
+// All returns every registered solver ordered by year and day.
+func All() []Solver {
+	all := make([]Solver, 0, len(solvers))
+	for _, s := range solvers {
+		all = append(all, s)
+	}
+
+	slices.SortFunc(all, func(a, b Solver) int {
+		if a.Year != b.Year {
+			return a.Year - b.Year
+		}
+		return a.Day - b.Day
+	})
+	return all
+}
@@ -0,0 +1,213 @@
+package runner
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/mbark/aoc2025/registry"
+)
+
+// Benchmark is the statistics from running one part of a puzzle repeatedly.
+type Benchmark struct {
+	Label   string        `json:"label,omitempty"`
+	Year    int           `json:"year"`
+	Day     int           `json:"day"`
+	Part    int           `json:"part"`
+	Example string        `json:"example,omitempty"`
+	Runs    int           `json:"runs"`
+	Mean    time.Duration `json:"mean"`
+	Median  time.Duration `json:"median"`
+	P95     time.Duration `json:"p95"`
+	Stddev  time.Duration `json:"stddev"`
+	// Allocs and Bytes are averages per run.
+	Allocs uint64 `json:"allocs"`
+	Bytes  uint64 `json:"bytes"`
+	Err    error  `json:"-"`
+}
+
+// Bench runs the solver's parts warmup times without measuring and then runs
+// times while measuring.
+func Bench(ctx context.Context, s registry.Solver, input string, opts Options, warmup, runs int) []Benchmark {
+	params := s.InputParams().With(opts.Overrides)
+	var benchmarks []Benchmark
+	for i, p := range s.Parts {
+		if !opts.runs(i + 1) {
+			continue
+		}
+
+		b := BenchPart(opts.context(ctx, s, i+1, ""), p, input, params, opts.Timeout, warmup, runs)
+		b.Year, b.Day, b.Part = s.Year, s.Day, i+1
+		benchmarks = append(benchmarks, b)
+	}
+
+	return benchmarks
+}
+
+// BenchExample benchmarks the parts of the solver the example applies to.
+func BenchExample(ctx context.Context, s registry.Solver, e registry.Example, opts Options, warmup, runs int) []Benchmark {
+	params := s.ExampleParams(e).With(opts.Overrides)
+	var benchmarks []Benchmark
+	for _, part := range e.Parts() {
+		if !opts.runs(part) {
+			continue
+		}
+
+		b := Benchmark{Err: fmt.Errorf("no part %d", part)}
+		if part >= 1 && part <= len(s.Parts) {
+			b = BenchPart(opts.context(ctx, s, part, e.Name), s.Parts[part-1], e.Input, params, opts.Timeout, warmup, runs)
+		}
+
+		b.Year, b.Day, b.Part, b.Example = s.Year, s.Day, part, e.Name
+		benchmarks = append(benchmarks, b)
+	}
+
+	return benchmarks
+}
+
+// BenchPart benchmarks a single part, stopping at the first error. The timeout
+// applies to each run, and runs must be at least 1.
+func BenchPart(ctx context.Context, p registry.Part, input string, params registry.Params, timeout time.Duration, warmup, runs int) Benchmark {
+	if runs < 1 {
+		return Benchmark{Err: fmt.Errorf("%d runs, want at least 1", runs)}
+	}
+
+	for range warmup {
+		if r := RunPart(ctx, p, input, params, timeout); r.Err != nil {
+			return Benchmark{Err: r.Err}
+		}
+	}
+
+	durations := make([]time.Duration, runs)
+	var allocs, bytes uint64
+	for i := range runs {
+		r := RunPart(ctx, p, input, params, timeout)
+		if r.Err != nil {
+			return Benchmark{Runs: i, Err: r.Err}
+		}
+
+		durations[i] = r.Duration
+		allocs += r.Allocs
+		bytes += r.Bytes
+	}
+
+	b := summarize(durations)
+	b.Allocs = allocs / uint64(runs)
+	b.Bytes = bytes / uint64(runs)
+	return b
+}
+
+// summarize returns the statistics of the durations, which must not be empty.
+func summarize(durations []time.Duration) Benchmark {
+	runs := len(durations)
+	durations = slices.Sorted(slices.Values(durations))
+
+	var sum time.Duration
+	for _, d := range durations {
+		sum += d
+	}
+
+	b := Benchmark{Runs: runs}
+	b.Mean = sum / time.Duration(runs)
+	b.Median = durations[runs/2]
+	if runs%2 == 0 {
+		b.Median = (durations[runs/2-1] + durations[runs/2]) / 2
+	}
+	b.P95 = durations[int(math.Ceil(0.95*float64(runs)))-1]
+
+	var variance float64
+	for _, d := range durations {
+		diff := float64(d - b.Mean)
+		variance += diff * diff
+	}
+	b.Stddev = time.Duration(math.Sqrt(variance / float64(runs)))
+	return b
+}
+
+func WriteBenchTable(w io.Writer, benchmarks []Benchmark) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "day\tpart\tinput\truns\tmean\tmedian\tp95\tstddev\tallocs/op\tbytes/op\t")
+
+	var errs []Benchmark
+	for _, b := range benchmarks {
+		if b.Err != nil {
+			errs = append(errs, b)
+			fmt.Fprintf(tw, "%d\t%d\t%s\t%d\terror\t\t\t\t\t\t\n", b.Day, b.Part, InputName(b.Example), b.Runs)
+			continue
+		}
+
+		fmt.Fprintf(tw, "%d\t%d\t%s\t%d\t%s\t%s\t%s\t%s\t%d\t%d\t\n",
+			b.Day, b.Part, InputName(b.Example), b.Runs, round(b.Mean), round(b.Median), round(b.P95), round(b.Stddev), b.Allocs, b.Bytes)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	for _, b := range errs {
+		if _, err := fmt.Fprintf(w, "day %d part %d: %s\n", b.Day, b.Part, b.Err); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteBenchCSV writes the benchmarks as CSV with durations in nanoseconds.
+func WriteBenchCSV(w io.Writer, benchmarks []Benchmark) error {
+	cw := csv.NewWriter(w)
+	_ = cw.Write([]string{"label", "year", "day", "part", "example", "runs", "mean_ns", "median_ns", "p95_ns", "stddev_ns", "allocs", "bytes", "error"})
+	for _, b := range benchmarks {
+		var errMsg string
+		if b.Err != nil {
+			errMsg = b.Err.Error()
+		}
+
+		_ = cw.Write([]string{
+			b.Label,
+			strconv.Itoa(b.Year),
+			strconv.Itoa(b.Day),
+			strconv.Itoa(b.Part),
+			b.Example,
+			strconv.Itoa(b.Runs),
+			strconv.FormatInt(int64(b.Mean), 10),
+			strconv.FormatInt(int64(b.Median), 10),
+			strconv.FormatInt(int64(b.P95), 10),
+			strconv.FormatInt(int64(b.Stddev), 10),
+			strconv.FormatUint(b.Allocs, 10),
+			strconv.FormatUint(b.Bytes, 10),
+			errMsg,
+		})
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteBenchJSON writes each benchmark as a JSON object on its own line, like
+// WriteJSON, with durations in nanoseconds.
+func WriteBenchJSON(w io.Writer, benchmarks []Benchmark) error {
+	type jsonBenchmark struct {
+		Benchmark
+		Error string `json:"error,omitempty"`
+	}
+
+	enc := json.NewEncoder(w)
+	for _, b := range benchmarks {
+		out := jsonBenchmark{Benchmark: b}
+		if b.Err != nil {
+			out.Error = b.Err.Error()
+		}
+
+		if err := enc.Encode(out); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
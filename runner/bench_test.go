@@ -0,0 +1,61 @@
+package runner
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSummarize(t *testing.T) {
+	ms := func(ns ...int) []time.Duration {
+		durations := make([]time.Duration, len(ns))
+		for i, n := range ns {
+			durations[i] = time.Duration(n) * time.Millisecond
+		}
+		return durations
+	}
+
+	tests := []struct {
+		durations                 []time.Duration
+		mean, median, p95, stddev time.Duration
+	}{
+		{ms(5), 5 * time.Millisecond, 5 * time.Millisecond, 5 * time.Millisecond, 0},
+		{ms(4, 2), 3 * time.Millisecond, 3 * time.Millisecond, 4 * time.Millisecond, time.Millisecond},
+		{ms(3, 1, 2), 2 * time.Millisecond, 2 * time.Millisecond, 3 * time.Millisecond, 816496 * time.Nanosecond},
+		{ms(2, 4, 4, 4, 5, 5, 7, 9), 5 * time.Millisecond, 4500 * time.Microsecond, 9 * time.Millisecond, 2 * time.Millisecond},
+		{ms(20, 19, 18, 17, 16, 15, 14, 13, 12, 11, 10, 9, 8, 7, 6, 5, 4, 3, 2, 1), 10500 * time.Microsecond, 10500 * time.Microsecond, 19 * time.Millisecond, 5766281 * time.Nanosecond},
+	}
+
+	for _, tt := range tests {
+		b := summarize(tt.durations)
+		if b.Runs != len(tt.durations) || b.Mean != tt.mean || b.Median != tt.median || b.P95 != tt.p95 || b.Stddev != tt.stddev {
+			t.Errorf("%v: got runs %d, mean %s, median %s, p95 %s, stddev %s, want mean %s, median %s, p95 %s, stddev %s",
+				tt.durations, b.Runs, b.Mean, b.Median, b.P95, b.Stddev, tt.mean, tt.median, tt.p95, tt.stddev)
+		}
+	}
+}
+
+func TestBenchPartRuns(t *testing.T) {
+	if b := BenchPart(t.Context(), nil, "", nil, 0, 0, 0); b.Err == nil {
+		t.Error("got no error for 0 runs")
+	}
+}
+
+func TestWriteBenchJSON(t *testing.T) {
+	var sb strings.Builder
+	err := WriteBenchJSON(&sb, []Benchmark{
+		{Year: 2025, Day: 1, Part: 1, Runs: 2, Mean: 3},
+		{Year: 2025, Day: 1, Part: 2, Err: errors.New("failed")},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `{"year":2025,"day":1,"part":1,"runs":2,"mean":3,"median":0,"p95":0,"stddev":0,"allocs":0,"bytes":0}
+{"year":2025,"day":1,"part":2,"runs":0,"mean":0,"median":0,"p95":0,"stddev":0,"allocs":0,"bytes":0,"error":"failed"}
+`
+	if sb.String() != want {
+		t.Errorf("got\n%s\nwant\n%s", sb.String(), want)
+	}
+}
@@ -0,0 +1,93 @@
+package runner
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/mbark/aoc2025/registry"
+)
+
+type jsonResult struct {
+	Year     int    `json:"year"`
+	Day      int    `json:"day"`
+	Part     int    `json:"part"`
+	Example  string `json:"example,omitempty"`
+	Status   string `json:"status"`
+	Answer   string `json:"answer,omitempty"`
+	Expected string `json:"expected,omitempty"`
+	// Duration is in nanoseconds.
+	Duration int64  `json:"duration"`
+	Allocs   uint64 `json:"allocs"`
+	Bytes    uint64 `json:"bytes"`
+	Error    string `json:"error,omitempty"`
+}
+
+// WriteJSON writes each result as a JSON object on its own line.
+func WriteJSON(w io.Writer, results []Result) error {
+	enc := json.NewEncoder(w)
+	for _, r := range results {
+		out := jsonResult{
+			Year:     r.Year,
+			Day:      r.Day,
+			Part:     r.Part,
+			Example:  r.Example,
+			Status:   r.Status(),
+			Answer:   r.Answer.String(),
+			Expected: r.Expected.String(),
+			Duration: r.Duration.Nanoseconds(),
+			Allocs:   r.Allocs,
+			Bytes:    r.Bytes,
+		}
+		if r.Err != nil {
+			out.Error = r.Err.Error()
+		}
+
+		if err := enc.Encode(out); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ReadJSON reads results written by WriteJSON, answers are read back as
+// strings.
+func ReadJSON(r io.Reader) ([]Result, error) {
+	var results []Result
+	dec := json.NewDecoder(r)
+	for {
+		var in jsonResult
+		err := dec.Decode(&in)
+		if errors.Is(err, io.EOF) {
+			return results, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		res := Result{
+			Year:     in.Year,
+			Day:      in.Day,
+			Part:     in.Part,
+			Example:  in.Example,
+			Duration: time.Duration(in.Duration),
+			Allocs:   in.Allocs,
+			Bytes:    in.Bytes,
+		}
+		if in.Answer != "" {
+			res.Answer = registry.String(in.Answer)
+		}
+		if in.Expected != "" {
+			res.Expected = registry.String(in.Expected)
+		}
+		switch {
+		case in.Status == "timeout":
+			res.Err = ErrTimeout
+		case in.Error != "":
+			res.Err = errors.New(in.Error)
+		}
+		results = append(results, res)
+	}
+}
@@ -0,0 +1,181 @@
+package runner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"runtime"
+	"slices"
+	"time"
+
+	"github.com/mbark/aoc2025/logging"
+	"github.com/mbark/aoc2025/registry"
+)
+
+// Result is the outcome of running one part of a puzzle.
+type Result struct {
+	Year int
+	Day  int
+	Part int
+	// Example is the name of the example that was run, empty for the real
+	// input, and Expected is the example's answer.
+	Example  string
+	Expected registry.Answer
+	Answer   registry.Answer
+	Duration time.Duration
+	// Allocs and Bytes are the number of heap allocations and bytes
+	// allocated while running the part.
+	Allocs uint64
+	Bytes  uint64
+	Err    error
+}
+
+// ErrTimeout is the error of a part that didn't finish within the timeout.
+var ErrTimeout = errors.New("timeout")
+
+// IsWrong is true if the answer isn't the expected one.
+func (r Result) IsWrong() bool {
+	return r.Err == nil && !r.Expected.IsZero() && !r.Answer.Equal(r.Expected)
+}
+
+func (r Result) TimedOut() bool {
+	return errors.Is(r.Err, ErrTimeout)
+}
+
+// Status is one of ok, wrong, timeout or error.
+func (r Result) Status() string {
+	switch {
+	case r.TimedOut():
+		return "timeout"
+	case r.Err != nil:
+		return "error"
+	case r.IsWrong():
+		return "wrong"
+	default:
+		return "ok"
+	}
+}
+
+// Options controls how a solver is run.
+type Options struct {
+	// Parts lists the parts to run, every part is run if it is empty.
+	Parts []int
+	// Timeout is the longest a part may run for, zero means no limit.
+	Timeout time.Duration
+	// Overrides replaces the solver's default params.
+	Overrides registry.Params
+	// Logger is given to the parts through their context, nothing is logged
+	// if it is nil.
+	Logger *slog.Logger
+}
+
+func (o Options) runs(part int) bool {
+	return len(o.Parts) == 0 || slices.Contains(o.Parts, part)
+}
+
+// context returns the context for running the part, with a logger that tags
+// its output with the day, part and example.
+func (o Options) context(ctx context.Context, s registry.Solver, part int, example string) context.Context {
+	if o.Logger == nil {
+		return ctx
+	}
+
+	l := o.Logger.With("day", s.Day, "part", part)
+	if example != "" {
+		l = l.With("example", example)
+	}
+	return logging.WithLogger(ctx, l)
+}
+
+// Run runs the solver's parts on the input.
+func Run(ctx context.Context, s registry.Solver, input string, opts Options) []Result {
+	params := s.InputParams().With(opts.Overrides)
+	var results []Result
+	for i, p := range s.Parts {
+		if !opts.runs(i + 1) {
+			continue
+		}
+
+		r := RunPart(opts.context(ctx, s, i+1, ""), p, input, params, opts.Timeout)
+		r.Year, r.Day, r.Part = s.Year, s.Day, i+1
+		results = append(results, r)
+	}
+
+	return results
+}
+
+// RunExample runs the parts of the solver the example applies to.
+func RunExample(ctx context.Context, s registry.Solver, e registry.Example, opts Options) []Result {
+	params := s.ExampleParams(e).With(opts.Overrides)
+	var results []Result
+	for _, part := range e.Parts() {
+		if !opts.runs(part) {
+			continue
+		}
+
+		r := Result{Err: fmt.Errorf("no part %d", part)}
+		if part >= 1 && part <= len(s.Parts) {
+			r = RunPart(opts.context(ctx, s, part, e.Name), s.Parts[part-1], e.Input, params, opts.Timeout)
+		}
+
+		r.Year, r.Day, r.Part = s.Year, s.Day, part
+		r.Example = e.Name
+		// the expected answers only hold for the example's own params
+		if len(opts.Overrides) == 0 {
+			r.Expected = e.Answers[part]
+		}
+		results = append(results, r)
+	}
+
+	return results
+}
+
+// RunPart runs and measures a single part, a panic is returned as an error.
+// If the context is cancelled, or the part runs for longer than the timeout
+// and ErrTimeout is returned, RunPart returns at once. A part that doesn't
+// check its context is left running in the background.
+func RunPart(ctx context.Context, p registry.Part, input string, params registry.Params, timeout time.Duration) Result {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	start := time.Now()
+	done := make(chan Result, 1)
+	go func() { done <- measure(ctx, p, input, params) }()
+
+	select {
+	case r := <-done:
+		if timeout > 0 && errors.Is(r.Err, context.DeadlineExceeded) {
+			r.Err = ErrTimeout
+		}
+		return r
+	case <-ctx.Done():
+		if timeout > 0 && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return Result{Duration: timeout, Err: ErrTimeout}
+		}
+		return Result{Duration: time.Since(start), Err: ctx.Err()}
+	}
+}
+
+func measure(ctx context.Context, p registry.Part, input string, params registry.Params) (r Result) {
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	start := time.Now()
+
+	defer func() {
+		r.Duration = time.Since(start)
+		runtime.ReadMemStats(&after)
+		r.Allocs = after.Mallocs - before.Mallocs
+		r.Bytes = after.TotalAlloc - before.TotalAlloc
+
+		if v := recover(); v != nil {
+			r.Err = fmt.Errorf("panic: %v", v)
+		}
+	}()
+
+	r.Answer, r.Err = p(ctx, input, params)
+	return r
+}
@@ -0,0 +1,48 @@
+package runner
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/mbark/aoc2025/registry"
+)
+
+func TestRunPartCancelled(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	// the part ignores its context and only returns once the test is over
+	part := func(ctx context.Context, input string, params registry.Params) (registry.Answer, error) {
+		<-release
+		return registry.Int(1), nil
+	}
+
+	ctx, cancel := context.WithCancel(t.Context())
+	time.AfterFunc(10*time.Millisecond, cancel)
+
+	done := make(chan Result, 1)
+	go func() { done <- RunPart(ctx, part, "", nil, 0) }()
+
+	select {
+	case r := <-done:
+		if !errors.Is(r.Err, context.Canceled) {
+			t.Errorf("got %v, want %v", r.Err, context.Canceled)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("RunPart didn't return after the context was cancelled")
+	}
+}
+
+func TestRunPartTimeout(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	part := func(ctx context.Context, input string, params registry.Params) (registry.Answer, error) {
+		<-release
+		return registry.Int(1), nil
+	}
+
+	if r := RunPart(t.Context(), part, "", nil, 10*time.Millisecond); !r.TimedOut() {
+		t.Errorf("got %v, want a timeout", r.Err)
+	}
+}
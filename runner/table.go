@@ -0,0 +1,67 @@
+package runner
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+)
+
+// WriteTable writes the results as a table with a total at the bottom, any
+// errors are listed below the table.
+func WriteTable(w io.Writer, results []Result) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "day\tpart\tinput\tanswer\ttime\tallocs\tbytes\t")
+
+	var total Result
+	var errs []Result
+	for _, r := range results {
+		answer := r.Answer.String()
+		switch {
+		case r.TimedOut():
+			answer = "timeout"
+		case r.Err != nil:
+			answer = "error"
+			errs = append(errs, r)
+		case r.IsWrong():
+			answer = fmt.Sprintf("%s (want %s)", r.Answer, r.Expected)
+		}
+
+		fmt.Fprintf(tw, "%d\t%d\t%s\t%s\t%s\t%d\t%d\t\n", r.Day, r.Part, InputName(r.Example), answer, round(r.Duration), r.Allocs, r.Bytes)
+		total.Duration += r.Duration
+		total.Allocs += r.Allocs
+		total.Bytes += r.Bytes
+	}
+
+	fmt.Fprintf(tw, "total\t\t\t\t%s\t%d\t%d\t\n", round(total.Duration), total.Allocs, total.Bytes)
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	for _, r := range errs {
+		if _, err := fmt.Fprintf(w, "day %d part %d: %s\n", r.Day, r.Part, r.Err); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func round(d time.Duration) time.Duration {
+	switch {
+	case d > time.Second:
+		return d.Round(time.Millisecond)
+	case d > time.Millisecond:
+		return d.Round(time.Microsecond)
+	default:
+		return d
+	}
+}
+
+// InputName is the name shown for the input a result is for, the example's
+// name or input for the real input.
+func InputName(example string) string {
+	if example == "" {
+		return "input"
+	}
+	return example
+}
@@ -0,0 +1,130 @@
+// Package scaffold generates the package for a new day.
+package scaffold
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	"go/format"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+//go:embed templates
+var templates embed.FS
+
+// Day is what is needed to generate a day's package.
+type Day struct {
+	Module string
+	// Dir is the directory of the package relative to the module root.
+	Dir  string
+	Year int
+	Day  int
+	Name string
+	// Example is the example input and Answers the expected answer for each
+	// part, both are left empty if they aren't known.
+	Example string
+	Answers []string
+}
+
+// Create writes the day's package, with a test, into root and adds it to the
+// days package so it is registered. An existing day is never overwritten.
+func Create(root string, d Day) error {
+	dir := filepath.Join(root, d.Dir)
+	if _, err := os.Stat(dir); err == nil {
+		return fmt.Errorf("%s already exists", dir)
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	files := map[string]string{
+		"main.go.tmpl":      "main.go",
+		"main_test.go.tmpl": "main_test.go",
+	}
+	rendered := make(map[string][]byte, len(files))
+	for tmpl, name := range files {
+		b, err := render(tmpl, d)
+		if err != nil {
+			return err
+		}
+		rendered[name] = b
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	for name, b := range rendered {
+		if err := os.WriteFile(filepath.Join(dir, name), b, 0o644); err != nil {
+			return err
+		}
+	}
+
+	return addImport(filepath.Join(root, "days", "days.go"), d.Module+"/"+filepath.ToSlash(d.Dir))
+}
+
+func render(name string, d Day) ([]byte, error) {
+	if strings.Contains(d.Example, "`") {
+		d.Example = ""
+	}
+	if d.Example != "" && !strings.HasSuffix(d.Example, "\n") {
+		d.Example += "\n"
+	}
+
+	t, err := template.New(name).Funcs(template.FuncMap{"answer": d.answer}).ParseFS(templates, "templates/"+name)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, d); err != nil {
+		return nil, err
+	}
+
+	b, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("format %s: %w", name, err)
+	}
+	return b, nil
+}
+
+// answer returns the expression for the part's expected answer.
+func (d Day) answer(i int) string {
+	if i >= len(d.Answers) || d.Answers[i] == "" {
+		return "{}"
+	}
+	if _, err := strconv.Atoi(d.Answers[i]); err == nil {
+		return fmt.Sprintf("registry.Int(%s)", d.Answers[i])
+	}
+	return fmt.Sprintf("registry.String(%q)", d.Answers[i])
+}
+
+// addImport adds a blank import of the package to the file's import block.
+func addImport(path, pkg string) error {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	src := string(b)
+	line := fmt.Sprintf("\t_ %q\n", pkg)
+	if strings.Contains(src, line) {
+		return nil
+	}
+
+	end := strings.Index(src, "\n)")
+	if !strings.Contains(src, "import (") || end == -1 {
+		return fmt.Errorf("no import block in %s", path)
+	}
+	src = src[:end+1] + line + src[end+1:]
+
+	formatted, err := format.Source([]byte(src))
+	if err != nil {
+		return fmt.Errorf("format %s: %w", path, err)
+	}
+	return os.WriteFile(path, formatted, 0o644)
+}
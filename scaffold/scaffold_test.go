@@ -0,0 +1,124 @@
+package scaffold
+
+import (
+	"encoding/json"
+	"go/format"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const module = "github.com/mbark/aoc2025"
+
+// root creates a module root with a days package to add imports to.
+func root(t *testing.T) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	if err := os.Mkdir(filepath.Join(dir, "days"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	src := "package days\n\nimport (\n\t_ \"" + module + "/day1\"\n\t_ \"" + module + "/day3\"\n)\n"
+	if err := os.WriteFile(filepath.Join(dir, "days", "days.go"), []byte(src), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+func TestCreateRefusesExistingDay(t *testing.T) {
+	dir := root(t)
+	if err := os.Mkdir(filepath.Join(dir, "day5"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	err := Create(dir, Day{Module: module, Dir: "day5", Year: 2025, Day: 5, Name: "Test"})
+	if err == nil || !strings.Contains(err.Error(), "already exists") {
+		t.Errorf("got %v, want an error for the existing day", err)
+	}
+}
+
+func TestAddImportSorts(t *testing.T) {
+	dir := root(t)
+	path := filepath.Join(dir, "days", "days.go")
+	if err := addImport(path, module+"/day2"); err != nil {
+		t.Fatal(err)
+	}
+	// adding it again changes nothing
+	if err := addImport(path, module+"/day2"); err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "package days\n\nimport (\n\t_ \"" + module + "/day1\"\n\t_ \"" + module + "/day2\"\n\t_ \"" + module + "/day3\"\n)\n"
+	if string(b) != want {
+		t.Errorf("got\n%s\nwant\n%s", b, want)
+	}
+}
+
+// TestCreateCompiles generates a day and builds it as part of this module,
+// using an overlay so that the tree itself isn't changed.
+func TestCreateCompiles(t *testing.T) {
+	if testing.Short() {
+		t.Skip("builds the module")
+	}
+
+	dir := root(t)
+	d := Day{
+		Module:  module,
+		Dir:     "day99",
+		Year:    2025,
+		Day:     99,
+		Name:    `Foo "bar" \ baz`,
+		Example: "1 2\n3 4",
+		Answers: []string{"10", "abc"},
+	}
+	if err := Create(dir, d); err != nil {
+		t.Fatal(err)
+	}
+
+	moduleRoot, err := filepath.Abs("..")
+	if err != nil {
+		t.Fatal(err)
+	}
+	overlay := map[string]string{
+		filepath.Join(moduleRoot, "days", "days.go"): filepath.Join(dir, "days", "days.go"),
+	}
+	for _, name := range []string{"main.go", "main_test.go"} {
+		path := filepath.Join(dir, "day99", name)
+		b, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if formatted, err := format.Source(b); err != nil || string(formatted) != string(b) {
+			t.Errorf("%s isn't formatted: %v", name, err)
+		}
+		overlay[filepath.Join(moduleRoot, "day99", name)] = path
+	}
+
+	b, err := json.Marshal(map[string]any{"Replace": overlay})
+	if err != nil {
+		t.Fatal(err)
+	}
+	overlayPath := filepath.Join(dir, "overlay.json")
+	if err := os.WriteFile(overlayPath, b, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	// the days package must still build with the new day added, and so must
+	// the day's test
+	for _, args := range [][]string{
+		{"build", "-overlay", overlayPath, "./days"},
+		{"test", "-c", "-vet=off", "-o", filepath.Join(dir, "day99.test"), "-overlay", overlayPath, "./day99"},
+	} {
+		cmd := exec.Command("go", args...)
+		cmd.Dir = moduleRoot
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("go %s: %v\n%s", args[0], err, out)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/mbark/aoc2025/aoc"
+	"github.com/mbark/aoc2025/registry"
+	"github.com/mbark/aoc2025/util"
+)
+
+func submit(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("submit", flag.ExitOnError)
+	var (
+		flagYear   = yearFlag(fs)
+		flagDay    = fs.Int("day", 0, "day to submit")
+		flagPart   = fs.Int("part", 1, "part to submit")
+		flagAnswer = fs.String("answer", "", "answer to submit, solved from the input if empty")
+		response   = fs.String("response", "", "parse a saved response page instead of posting the answer")
+	)
+	setupCache := cacheFlags(fs)
+	_ = fs.Parse(args)
+	if err := setupCache(); err != nil {
+		return err
+	}
+
+	answer := *flagAnswer
+	if answer == "" {
+		solver, ok := registry.Get(*flagYear, *flagDay)
+		if !ok {
+			return fmt.Errorf("%d day %d not implemented", *flagYear, *flagDay)
+		}
+		if *flagPart < 1 || *flagPart > len(solver.Parts) {
+			return fmt.Errorf("day %d has no part %d", *flagDay, *flagPart)
+		}
+
+		input, err := util.GetInput(*flagYear, *flagDay)
+		if err != nil {
+			return err
+		}
+		a, err := solver.Parts[*flagPart-1](ctx, input, solver.InputParams())
+		if err != nil {
+			return err
+		}
+		answer = a.String()
+	}
+
+	history, err := aoc.LoadHistory(util.Cache.Dir, *flagYear, *flagDay)
+	if err != nil {
+		return err
+	}
+	if err := history.Check(*flagPart, answer); err != nil {
+		return err
+	}
+
+	if *response != "" {
+		b, err := os.ReadFile(*response)
+		if err != nil {
+			return err
+		}
+
+		printResponse(aoc.ParseResponse(string(b)))
+		return nil
+	}
+
+	fmt.Printf("submitting %s for %d day %d part %d\n", answer, *flagYear, *flagDay, *flagPart)
+	res, err := util.Client.Submit(ctx, *flagYear, *flagDay, *flagPart, answer)
+	if err != nil {
+		return err
+	}
+
+	printResponse(res)
+	return history.Add(aoc.Submission{Part: *flagPart, Answer: answer, Outcome: res.Outcome, Time: time.Now()})
+}
+
+func printResponse(res aoc.Response) {
+	fmt.Println(res.Outcome)
+	if res.Wait > 0 {
+		fmt.Printf("wait %s before submitting again\n", res.Wait)
+	}
+	if res.Outcome == aoc.Unknown {
+		fmt.Println(res.Message)
+	}
+}
@@ -0,0 +1,104 @@
+package util
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// CacheMode controls how GetInput uses the input cache.
+type CacheMode int
+
+const (
+	// CacheDefault reads from the cache and only downloads missing inputs.
+	CacheDefault CacheMode = iota
+	// CacheOffline never downloads anything, a missing input is an error.
+	CacheOffline
+	// CacheRefresh always downloads the input and overwrites the cache.
+	CacheRefresh
+)
+
+// InputCache stores puzzle inputs on disk, keyed by year and day, so that
+// they only have to be downloaded once.
+type InputCache struct {
+	Dir  string
+	Mode CacheMode
+}
+
+// DefaultCacheDir returns $AOC_CACHE if it is set and .inputs otherwise.
+func DefaultCacheDir() string {
+	if dir := os.Getenv("AOC_CACHE"); dir != "" {
+		return dir
+	}
+	return ".inputs"
+}
+
+func (c InputCache) Path(year, day int) string {
+	return filepath.Join(c.Dir, fmt.Sprintf("%d", year), fmt.Sprintf("day%02d.txt", day))
+}
+
+// Read returns the cached input, ok is false if it hasn't been cached.
+func (c InputCache) Read(year, day int) (input string, ok bool, err error) {
+	b, err := os.ReadFile(c.Path(year, day))
+	if errors.Is(err, fs.ErrNotExist) {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, err
+	}
+
+	return string(b), true, nil
+}
+
+// Write stores the input in the cache.
+func (c InputCache) Write(year, day int, input string) error {
+	return c.write(c.Path(year, day), []byte(input))
+}
+
+// LeaderboardPath is where the private leaderboard with the id is cached.
+func (c InputCache) LeaderboardPath(year int, id string) string {
+	return filepath.Join(c.Dir, fmt.Sprintf("%d", year), fmt.Sprintf("leaderboard-%s.json", id))
+}
+
+// ReadLeaderboard returns the cached leaderboard and when it was cached, ok is
+// false if it hasn't been cached.
+func (c InputCache) ReadLeaderboard(year int, id string) (b []byte, cached time.Time, ok bool, err error) {
+	path := c.LeaderboardPath(year, id)
+	info, err := os.Stat(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, time.Time{}, false, nil
+	}
+	if err != nil {
+		return nil, time.Time{}, false, err
+	}
+
+	b, err = os.ReadFile(path)
+	if err != nil {
+		return nil, time.Time{}, false, err
+	}
+	return b, info.ModTime(), true, nil
+}
+
+func (c InputCache) WriteLeaderboard(year int, id string, b []byte) error {
+	return c.write(c.LeaderboardPath(year, id), b)
+}
+
+// write stores the file in the cache. Files are only readable by the current
+// user and the cache directory ignores itself so they are never committed.
+func (c InputCache) write(path string, b []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+
+	gitignore := filepath.Join(c.Dir, ".gitignore")
+	if _, err := os.Stat(gitignore); errors.Is(err, fs.ErrNotExist) {
+		if err := os.WriteFile(gitignore, []byte("*\n"), 0o600); err != nil {
+			return err
+		}
+	}
+
+	return os.WriteFile(path, b, 0o600)
+}
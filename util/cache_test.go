@@ -0,0 +1,127 @@
+package util
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mbark/aoc2025/aoc"
+)
+
+// useCache points GetInput at a cache in a temporary directory and a client
+// talking to the handler, restoring both when the test ends.
+func useCache(t *testing.T, mode CacheMode, handler http.HandlerFunc) InputCache {
+	t.Helper()
+
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	oldCache, oldClient := Cache, Client
+	t.Cleanup(func() { Cache, Client = oldCache, oldClient })
+
+	Cache = InputCache{Dir: t.TempDir(), Mode: mode}
+	Client = aoc.NewClient("session")
+	Client.BaseURL = srv.URL
+	return Cache
+}
+
+func serve(input string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(input))
+	}
+}
+
+func failIfCalled(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request for %s", r.URL.Path)
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
+
+func TestGetInputOfflineMiss(t *testing.T) {
+	useCache(t, CacheOffline, failIfCalled(t))
+
+	if _, err := GetInput(2025, 1); err == nil {
+		t.Error("got no error for an input that isn't cached")
+	}
+}
+
+func TestGetInputHit(t *testing.T) {
+	cache := useCache(t, CacheDefault, failIfCalled(t))
+	if err := cache.Write(2025, 1, "cached"); err != nil {
+		t.Fatal(err)
+	}
+
+	input, err := GetInput(2025, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if input != "cached" {
+		t.Errorf("input = %q, want %q", input, "cached")
+	}
+}
+
+func TestGetInputRefresh(t *testing.T) {
+	cache := useCache(t, CacheRefresh, serve("downloaded"))
+	if err := cache.Write(2025, 1, "cached"); err != nil {
+		t.Fatal(err)
+	}
+
+	input, err := GetInput(2025, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if input != "downloaded" {
+		t.Errorf("input = %q, want %q", input, "downloaded")
+	}
+
+	cached, ok, err := cache.Read(2025, 1)
+	if err != nil || !ok || cached != "downloaded" {
+		t.Errorf("cached = %q, %t, %v, want the downloaded input", cached, ok, err)
+	}
+}
+
+func TestGetInputDownloadsMiss(t *testing.T) {
+	cache := useCache(t, CacheDefault, serve("downloaded"))
+
+	if _, err := GetInput(2025, 3); err != nil {
+		t.Fatal(err)
+	}
+
+	info, err := os.Stat(cache.Path(2025, 3))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if perm := info.Mode().Perm(); perm != 0o600 {
+		t.Errorf("input permissions = %o, want 600", perm)
+	}
+
+	info, err = os.Stat(filepath.Dir(cache.Path(2025, 3)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if perm := info.Mode().Perm(); perm != 0o700 {
+		t.Errorf("directory permissions = %o, want 700", perm)
+	}
+
+	b, err := os.ReadFile(filepath.Join(cache.Dir, ".gitignore"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != "*\n" {
+		t.Errorf(".gitignore = %q, want %q", b, "*\n")
+	}
+}
+
+func TestGetLeaderboardInvalidID(t *testing.T) {
+	cache := useCache(t, CacheDefault, failIfCalled(t))
+
+	if _, _, err := GetLeaderboard(t.Context(), 2025, "../../x"); err == nil {
+		t.Error("got no error for a leaderboard id that isn't a number")
+	}
+	if entries, err := os.ReadDir(cache.Dir); err != nil || len(entries) > 0 {
+		t.Errorf("got %v, %v in the cache, want it to be empty", entries, err)
+	}
+}
@@ -1,32 +1,42 @@
 package util
 
 import (
+	"context"
 	"fmt"
-	"io"
-	"net/http"
-	"net/http/httputil"
-	"os"
 	"regexp"
-)
 
-func GetInput(day int) string {
-	req, err := http.NewRequest("GET", fmt.Sprintf("https://adventofcode.com/2025/day/%d/input", day), nil)
-	must(err)
-	req.Header.Set("cookie", "session="+os.Getenv("SESSION"))
+	"github.com/mbark/aoc2025/aoc"
+)
 
-	res, err := http.DefaultClient.Do(req)
-	must(err)
+var (
+	// Cache is the cache used by GetInput.
+	Cache = InputCache{Dir: DefaultCacheDir()}
+	// Client is used by GetInput to download inputs that aren't cached.
+	Client = aoc.NewClient(aoc.SessionFromEnv())
+)
 
-	if res.StatusCode != 200 {
-		d, err := httputil.DumpResponse(res, true)
-		fmt.Println(string(d), err)
-		panic(fmt.Sprintf("non-200 status code: %d", res.StatusCode))
+// GetInput returns the input for the year's day, it is read from the cache
+// when possible and otherwise downloaded and cached.
+func GetInput(year, day int) (string, error) {
+	if Cache.Mode != CacheRefresh {
+		input, ok, err := Cache.Read(year, day)
+		if err != nil {
+			return "", err
+		}
+		if ok {
+			return input, nil
+		}
+	}
+	if Cache.Mode == CacheOffline {
+		return "", fmt.Errorf("offline and no cached input for %d day %d", year, day)
 	}
 
-	bytes, err := io.ReadAll(res.Body)
-	must(err)
+	input, err := Client.Input(context.Background(), year, day)
+	if err != nil {
+		return "", err
+	}
 
-	return string(bytes)
+	return input, Cache.Write(year, day, input)
 }
 
 func RegexCaptureGroups(re *regexp.Regexp, input string) []map[string]string {
@@ -57,9 +67,3 @@ func RegexSubexps(re *regexp.Regexp, input string) []string {
 
 	return results
 }
-
-func must(err error) {
-	if err != nil {
-		panic(err)
-	}
-}
@@ -0,0 +1,60 @@
+package util
+
+import (
+	"iter"
+	"slices"
+)
+
+// Ints returns every number in s, ignoring anything that isn't a digit so
+// that 162,817,812 gives 162, 817 and 812. Minus signs are ignored too, use
+// IntsSigned for negative numbers.
+func Ints(s string) []int {
+	return slices.Collect(IntsSeq(s))
+}
+
+// IntsSigned returns every number in s like Ints, but a - right before a
+// number is a minus sign unless it directly follows a digit, in which case it
+// is a range dash. So x=-3 gives -3 while 3-5 gives 3 and 5, and 3--5 gives 3
+// and -5.
+func IntsSigned(s string) []int {
+	return slices.Collect(IntsSignedSeq(s))
+}
+
+// IntsSeq yields the numbers Ints returns without allocating.
+func IntsSeq(s string) iter.Seq[int] {
+	return ints(s, false)
+}
+
+// IntsSignedSeq yields the numbers IntsSigned returns without allocating.
+func IntsSignedSeq(s string) iter.Seq[int] {
+	return ints(s, true)
+}
+
+// ints yields the numbers in s, numbers too large for an int overflow.
+func ints(s string, signed bool) iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for i := 0; i < len(s); {
+			if !isDigit(s[i]) {
+				i++
+				continue
+			}
+
+			negative := signed && i > 0 && s[i-1] == '-' && (i < 2 || !isDigit(s[i-2]))
+			var n int
+			for ; i < len(s) && isDigit(s[i]); i++ {
+				n = n*10 + int(s[i]-'0')
+			}
+			if negative {
+				n = -n
+			}
+
+			if !yield(n) {
+				return
+			}
+		}
+	}
+}
+
+func isDigit(b byte) bool {
+	return '0' <= b && b <= '9'
+}
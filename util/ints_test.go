@@ -0,0 +1,43 @@
+package util
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestInts(t *testing.T) {
+	tests := []struct {
+		in             string
+		ints, intsSign []int
+	}{
+		{"162,817,812", []int{162, 817, 812}, []int{162, 817, 812}},
+		{"3-5", []int{3, 5}, []int{3, 5}},
+		{"x=-3, y=12", []int{3, 12}, []int{-3, 12}},
+		{"-3--5", []int{3, 5}, []int{-3, -5}},
+		{"p=0,4 v=3,-3", []int{0, 4, 3, 3}, []int{0, 4, 3, -3}},
+		{"a - 4", []int{4}, []int{4}},
+		{"no numbers", nil, nil},
+	}
+	for _, tt := range tests {
+		if got := Ints(tt.in); !slices.Equal(got, tt.ints) {
+			t.Errorf("Ints(%q) = %v, want %v", tt.in, got, tt.ints)
+		}
+		if got := IntsSigned(tt.in); !slices.Equal(got, tt.intsSign) {
+			t.Errorf("IntsSigned(%q) = %v, want %v", tt.in, got, tt.intsSign)
+		}
+	}
+}
+
+func TestIntsSeqDoesNotAllocate(t *testing.T) {
+	line := "p=0,4 v=3,-3 162,817,812 3-5"
+	allocs := testing.AllocsPerRun(100, func() {
+		var sum int
+		for n := range IntsSignedSeq(line) {
+			sum += n
+		}
+		_ = sum
+	})
+	if allocs != 0 {
+		t.Errorf("got %v allocs, want 0", allocs)
+	}
+}
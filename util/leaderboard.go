@@ -0,0 +1,48 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/mbark/aoc2025/aoc"
+)
+
+// LeaderboardTTL is how long a cached leaderboard is used before it is
+// downloaded again, the site asks for no more than one request every 15
+// minutes.
+const LeaderboardTTL = 15 * time.Minute
+
+// GetLeaderboard returns the private leaderboard and when it was downloaded.
+// It is read from the cache unless the cached copy is older than
+// LeaderboardTTL, in which case it is downloaded and cached again. The id must
+// be a number as it is part of both the cache path and the URL.
+func GetLeaderboard(ctx context.Context, year int, id string) (aoc.Leaderboard, time.Time, error) {
+	if _, err := strconv.Atoi(id); err != nil {
+		return aoc.Leaderboard{}, time.Time{}, fmt.Errorf("leaderboard id %q isn't a number", id)
+	}
+
+	b, cached, ok, err := Cache.ReadLeaderboard(year, id)
+	if err != nil {
+		return aoc.Leaderboard{}, time.Time{}, err
+	}
+
+	stale := !ok || time.Since(cached) > LeaderboardTTL
+	switch {
+	case Cache.Mode == CacheOffline && !ok:
+		return aoc.Leaderboard{}, time.Time{}, fmt.Errorf("offline and no cached leaderboard %s for %d", id, year)
+	case Cache.Mode == CacheRefresh || (stale && Cache.Mode != CacheOffline):
+		b, err = Client.LeaderboardJSON(ctx, year, id)
+		if err != nil {
+			return aoc.Leaderboard{}, time.Time{}, err
+		}
+		if err := Cache.WriteLeaderboard(year, id, b); err != nil {
+			return aoc.Leaderboard{}, time.Time{}, err
+		}
+		cached = time.Now()
+	}
+
+	l, err := aoc.ParseLeaderboard(b)
+	return l, cached, err
+}
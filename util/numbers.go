@@ -0,0 +1,110 @@
+package util
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// NumberError is the error for a token that isn't a number. Line is 1-based
+// and zero when parsing a single token.
+type NumberError struct {
+	Line  int
+	Token string
+	Err   error
+}
+
+func (e *NumberError) Error() string {
+	if e.Line == 0 {
+		return fmt.Sprintf("invalid number %q", e.Token)
+	}
+	return fmt.Sprintf("line %d: invalid number %q", e.Line, e.Token)
+}
+
+func (e *NumberError) Unwrap() error {
+	return e.Err
+}
+
+// NumberParser parses numbers, returning an error instead of zero for
+// anything that isn't one. A lenient parser trims whitespace, including the
+// \r of CRLF line endings, around each number while a strict one rejects it.
+type NumberParser struct {
+	Lenient bool
+}
+
+var (
+	Strict  = NumberParser{}
+	Lenient = NumberParser{Lenient: true}
+)
+
+func (p NumberParser) Str2Int(s string) (int, error) {
+	n, err := p.parse(s)
+	if err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+func (p NumberParser) parse(s string) (int, *NumberError) {
+	if p.Lenient {
+		s = strings.TrimSpace(s)
+	}
+
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, &NumberError{Token: s, Err: err}
+	}
+	return n, nil
+}
+
+// NumberList parses the numbers separated by the separator, skipping empty
+// tokens so that repeated separators are allowed.
+func (p NumberParser) NumberList(in, separator string) ([]int, error) {
+	return p.numberList(in, separator, 1)
+}
+
+// NumberLines parses each non-empty line as a list of numbers separated by the
+// separator.
+func (p NumberParser) NumberLines(in, separator string) ([][]int, error) {
+	var lines [][]int
+	for i, line := range strings.Split(in, "\n") {
+		if p.Lenient {
+			line = strings.TrimSpace(line)
+		}
+		if line == "" {
+			continue
+		}
+
+		list, err := p.numberList(line, separator, i+1)
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, list)
+	}
+
+	return lines, nil
+}
+
+// numberList parses the list, which starts at the line, counting the lines
+// it spans for errors.
+func (p NumberParser) numberList(in, separator string, line int) ([]int, error) {
+	var list []int
+	var offset int
+	for _, s := range strings.Split(in, separator) {
+		start := offset
+		offset += len(s) + len(separator)
+		if s == "" || (p.Lenient && strings.TrimSpace(s) == "") {
+			continue
+		}
+
+		n, err := p.parse(s)
+		if err != nil {
+			space := len(s) - len(strings.TrimLeft(s, " \t\r\n"))
+			err.Line = line + strings.Count(in[:start+space], "\n")
+			return nil, err
+		}
+		list = append(list, n)
+	}
+
+	return list, nil
+}
@@ -0,0 +1,60 @@
+package util
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestNumberList(t *testing.T) {
+	tests := []struct {
+		parser    NumberParser
+		in, sep   string
+		want      []int
+		line      int
+		token     string
+		wantError bool
+	}{
+		{parser: Strict, in: "1  2 -3", sep: " ", want: []int{1, 2, -3}},
+		{parser: Strict, in: "1 2\r", sep: " ", wantError: true, line: 1, token: "2\r"},
+		{parser: Lenient, in: "1 2\r", sep: " ", want: []int{1, 2}},
+		{parser: Strict, in: "1\n2\nx\n", sep: "\n", wantError: true, line: 3, token: "x"},
+		{parser: Lenient, in: "1\r\n2\r\n\r\n", sep: "\n", want: []int{1, 2}},
+		{parser: Strict, in: "1,2,\nx", sep: ",", wantError: true, line: 2, token: "\nx"},
+		{parser: Strict, in: "123", sep: "", want: []int{1, 2, 3}},
+	}
+	for _, tt := range tests {
+		got, err := tt.parser.NumberList(tt.in, tt.sep)
+		if !tt.wantError {
+			if err != nil || !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("%q: got %v, %v, want %v", tt.in, got, err, tt.want)
+			}
+			continue
+		}
+
+		var ne *NumberError
+		if !errors.As(err, &ne) || ne.Line != tt.line || ne.Token != tt.token {
+			t.Errorf("%q: got %v, want line %d with token %q", tt.in, err, tt.line, tt.token)
+		}
+	}
+}
+
+func TestNumberLines(t *testing.T) {
+	got, err := Lenient.NumberLines("1 2\r\n\r\n 3  4 \r\n", " ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := [][]int{{1, 2}, {3, 4}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	_, err = Strict.NumberLines("1 2\n3 4\n5 6\r\n", " ")
+	var ne *NumberError
+	if !errors.As(err, &ne) || ne.Line != 3 || ne.Token != "6\r" {
+		t.Errorf("got %v, want line 3 with token %q", err, "6\r")
+	}
+	if !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("got %v, want it to wrap strconv.ErrSyntax", err)
+	}
+}
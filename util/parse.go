@@ -0,0 +1,336 @@
+package util
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+	"iter"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ParseError is the error for a line that doesn't match the pattern, Line and
+// Col are 1-based.
+type ParseError struct {
+	Line int
+	Col  int
+	Err  error
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("line %d, column %d: %s", e.Line, e.Col, e.Err)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// Parse parses each non-empty line of the input into a T using the pattern.
+//
+// The pattern is matched literally except for fields: {Name} fills the
+// exported field Name with the text up to the first occurrence of the text
+// following it in the pattern, or the end of the line, and {Name...} fills a
+// slice with the items in that text, separated by commas or spaces. A { that
+// doesn't start a field, as in {{Name}}, is matched literally.
+//
+// Fields can be strings, bools, numbers, implement encoding.TextUnmarshaler
+// or be slices of those. Structs, and slices whose items can't just be split,
+// are parsed with the pattern in the field's parse tag, where {} and {...}
+// stand for the value itself:
+//
+//	type Diagram struct {
+//		Lights  string
+//		Buttons [][]int `parse:"({...})"`
+//		Joltage []int
+//	}
+//
+//	diagrams, err := util.Parse[Diagram](input, "[{Lights}] {Buttons...} {{Joltage...}}")
+//
+// A line that doesn't match gives a *ParseError.
+func Parse[T any](input, pattern string) ([]T, error) {
+	return parse[T](input, pattern, 1)
+}
+
+// ParseSection is Parse for the text of a section, the lines in errors count
+// from the start of the input rather than the section.
+func ParseSection[T any](s Section, pattern string) ([]T, error) {
+	return parse[T](s.Text, pattern, s.Line)
+}
+
+// parse is Parse for input starting on line start.
+func parse[T any](input, pattern string, start int) ([]T, error) {
+	segs, err := compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	var parsed []T
+	for i, line := range strings.Split(input, "\n") {
+		if line == "" {
+			continue
+		}
+
+		var t T
+		n, err := match(line, 1, segs, reflect.ValueOf(&t).Elem(), false)
+		if err == nil && n < len(line) {
+			err = errorAt(1+n, "unexpected %q", excerpt(line[n:]))
+		}
+		var pe *posError
+		if errors.As(err, &pe) {
+			return nil, &ParseError{Line: start + i, Col: pe.col, Err: pe.err}
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		parsed = append(parsed, t)
+	}
+
+	return parsed, nil
+}
+
+// posError is an error at a column in the line being parsed, as opposed to an
+// error in the pattern.
+type posError struct {
+	col int
+	err error
+}
+
+func (e *posError) Error() string {
+	return fmt.Sprintf("column %d: %s", e.col, e.err)
+}
+
+func errorAt(col int, format string, args ...any) error {
+	return &posError{col: col, err: fmt.Errorf(format, args...)}
+}
+
+// segment is either literal text or a field.
+type segment struct {
+	text  string
+	field bool
+	// name is the field's name, empty for the value itself.
+	name string
+	list bool
+}
+
+var reField = regexp.MustCompile(`^\{(\w*)(\.\.\.)?\}`)
+
+func compile(pattern string) ([]segment, error) {
+	var segs []segment
+	var text strings.Builder
+	for i := 0; i < len(pattern); {
+		m := reField.FindStringSubmatch(pattern[i:])
+		if m == nil {
+			text.WriteByte(pattern[i])
+			i++
+			continue
+		}
+
+		if text.Len() > 0 {
+			segs = append(segs, segment{text: text.String()})
+			text.Reset()
+		} else if len(segs) > 0 {
+			return nil, fmt.Errorf("pattern %q: %s must be separated from the field before it", pattern, m[0])
+		}
+		segs = append(segs, segment{field: true, name: m[1], list: m[2] != ""})
+		i += len(m[0])
+	}
+	if text.Len() > 0 {
+		segs = append(segs, segment{text: text.String()})
+	}
+
+	return segs, nil
+}
+
+// match fills v from the start of s and returns how much of s was used. col
+// is the column s starts at. The last field of an item in a list ends at the
+// next separator instead of the end of s.
+func match(s string, col int, segs []segment, v reflect.Value, item bool) (int, error) {
+	var pos int
+	for i, seg := range segs {
+		if !seg.field {
+			if !strings.HasPrefix(s[pos:], seg.text) {
+				return 0, errorAt(col+pos, "expected %q, found %q", seg.text, excerpt(s[pos:]))
+			}
+			pos += len(seg.text)
+			continue
+		}
+
+		end := len(s)
+		switch {
+		case i+1 < len(segs):
+			n := strings.Index(s[pos:], segs[i+1].text)
+			if n == -1 {
+				return 0, errorAt(col+pos, "expected %q after %q", segs[i+1].text, excerpt(s[pos:]))
+			}
+			end = pos + n
+		case item:
+			if n := strings.IndexAny(s[pos:], ", "); n != -1 {
+				end = pos + n
+			}
+		}
+
+		f, pattern, err := field(v, seg.name)
+		if err != nil {
+			return 0, err
+		}
+		if err := fill(f, pattern, s[pos:end], col+pos, seg.list); err != nil {
+			return 0, err
+		}
+		pos = end
+	}
+
+	return pos, nil
+}
+
+// field returns the struct field with the name and its parse tag, or v itself
+// if the name is empty.
+func field(v reflect.Value, name string) (reflect.Value, string, error) {
+	if name == "" {
+		return v, "", nil
+	}
+	if v.Kind() != reflect.Struct {
+		return reflect.Value{}, "", fmt.Errorf("field %s in pattern for %s, which isn't a struct", name, v.Type())
+	}
+
+	sf, ok := v.Type().FieldByName(name)
+	if !ok || !sf.IsExported() {
+		return reflect.Value{}, "", fmt.Errorf("%s has no exported field %s", v.Type(), name)
+	}
+	return v.FieldByIndex(sf.Index), sf.Tag.Get("parse"), nil
+}
+
+// fill sets v from s, with the pattern if there is one. For a list the
+// pattern is that of each item.
+func fill(v reflect.Value, pattern, s string, col int, list bool) error {
+	if !list {
+		if pattern == "" {
+			return scalar(v, s, col)
+		}
+
+		segs, err := compile(pattern)
+		if err != nil {
+			return err
+		}
+		n, err := match(s, col, segs, v, false)
+		if err != nil {
+			return err
+		}
+		if n < len(s) {
+			return errorAt(col+n, "unexpected %q", excerpt(s[n:]))
+		}
+		return nil
+	}
+
+	if v.Kind() != reflect.Slice {
+		return fmt.Errorf("can't parse a list into %s", v.Type())
+	}
+	v.Set(reflect.MakeSlice(v.Type(), 0, 0))
+
+	if pattern == "" {
+		for start, end := range items(s) {
+			item := reflect.New(v.Type().Elem()).Elem()
+			if err := scalar(item, s[start:end], col+start); err != nil {
+				return err
+			}
+			v.Set(reflect.Append(v, item))
+		}
+		return nil
+	}
+
+	segs, err := compile(pattern)
+	if err != nil {
+		return err
+	}
+	for pos := skipSeparators(s, 0); pos < len(s); {
+		item := reflect.New(v.Type().Elem()).Elem()
+		n, err := match(s[pos:], col+pos, segs, item, true)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return errorAt(col+pos, "empty item")
+		}
+
+		v.Set(reflect.Append(v, item))
+		pos = skipSeparators(s, pos+n)
+	}
+	return nil
+}
+
+// items yields the start and end of each item in s, separated by commas or
+// spaces.
+func items(s string) iter.Seq2[int, int] {
+	return func(yield func(int, int) bool) {
+		for pos := skipSeparators(s, 0); pos < len(s); {
+			end := pos + strings.IndexAny(s[pos:], ", ")
+			if end < pos {
+				end = len(s)
+			}
+			if !yield(pos, end) {
+				return
+			}
+			pos = skipSeparators(s, end)
+		}
+	}
+}
+
+func skipSeparators(s string, pos int) int {
+	for pos < len(s) && (s[pos] == ',' || s[pos] == ' ') {
+		pos++
+	}
+	return pos
+}
+
+func scalar(v reflect.Value, s string, col int) error {
+	if u, ok := v.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		if err := u.UnmarshalText([]byte(s)); err != nil {
+			return &posError{col: col, err: err}
+		}
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			return errorAt(col, "invalid number %q", excerpt(s))
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(s, 10, v.Type().Bits())
+		if err != nil {
+			return errorAt(col, "invalid number %q", excerpt(s))
+		}
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, v.Type().Bits())
+		if err != nil {
+			return errorAt(col, "invalid number %q", excerpt(s))
+		}
+		v.SetFloat(f)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return errorAt(col, "invalid bool %q", excerpt(s))
+		}
+		v.SetBool(b)
+	case reflect.Struct:
+		return fmt.Errorf("can't parse %s without a pattern, add a parse tag", v.Type())
+	default:
+		return fmt.Errorf("can't parse into %s", v.Type())
+	}
+	return nil
+}
+
+// excerpt shortens s for error messages.
+func excerpt(s string) string {
+	if len(s) > 12 {
+		return s[:12] + "..."
+	}
+	return s
+}
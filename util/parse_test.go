@@ -0,0 +1,134 @@
+package util
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+type point struct {
+	X, Y int
+}
+
+type diagram struct {
+	Lights  string
+	Buttons [][]int `parse:"({...})"`
+	Joltage []int
+}
+
+type region struct {
+	Size   point `parse:"{X}x{Y}"`
+	Counts []uint8
+}
+
+type segment2 struct {
+	From point `parse:"{X},{Y}"`
+	To   point `parse:"{X},{Y}"`
+}
+
+type ranges struct {
+	Name   string
+	Ranges []point `parse:"{X}-{Y}"`
+}
+
+func TestParse(t *testing.T) {
+	points, err := Parse[point]("\n1,2\n-3,40\n", "{X},{Y}")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []point{{1, 2}, {-3, 40}}; !reflect.DeepEqual(points, want) {
+		t.Errorf("points = %v, want %v", points, want)
+	}
+
+	diagrams, err := Parse[diagram]("[.##.] (3) (1,3) (2) {3,5,4,7}", "[{Lights}] {Buttons...} {{Joltage...}}")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []diagram{{Lights: ".##.", Buttons: [][]int{{3}, {1, 3}, {2}}, Joltage: []int{3, 5, 4, 7}}}
+	if !reflect.DeepEqual(diagrams, want) {
+		t.Errorf("diagrams = %v, want %v", diagrams, want)
+	}
+
+	regions, err := Parse[region]("4x4: 0 0 1 0 2", "{Size}: {Counts...}")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []region{{point{4, 4}, []uint8{0, 0, 1, 0, 2}}}; !reflect.DeepEqual(regions, want) {
+		t.Errorf("regions = %v, want %v", regions, want)
+	}
+
+	segments, err := Parse[segment2]("0,9 -> 5,9", "{From} -> {To}")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []segment2{{point{0, 9}, point{5, 9}}}; !reflect.DeepEqual(segments, want) {
+		t.Errorf("segments = %v, want %v", segments, want)
+	}
+
+	rs, err := Parse[ranges]("a: 1-3, 5-7", "{Name}: {Ranges...}")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []ranges{{"a", []point{{1, 3}, {5, 7}}}}; !reflect.DeepEqual(rs, want) {
+		t.Errorf("ranges = %v, want %v", rs, want)
+	}
+
+	ints, err := Parse[int]("x=1\nx=2", "x={}")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []int{1, 2}; !reflect.DeepEqual(ints, want) {
+		t.Errorf("ints = %v, want %v", ints, want)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		input     string
+		pattern   string
+		line, col int
+	}{
+		{"1,2\n3;4", "{X},{Y}", 2, 1},
+		{"1,2\n3,x", "{X},{Y}", 2, 3},
+		{"p=(1,2)!", "p=({X},{Y})", 1, 8},
+		{"p=1,2!", "p={X},{Y}", 1, 5},
+		{"[#] (1,a) {1}", "[{Lights}] {Buttons...} {{Joltage...}}", 1, 8},
+	}
+	for _, tt := range tests {
+		var err error
+		if tt.pattern[0] == '[' {
+			_, err = Parse[diagram](tt.input, tt.pattern)
+		} else {
+			_, err = Parse[point](tt.input, tt.pattern)
+		}
+
+		var pe *ParseError
+		if !errors.As(err, &pe) {
+			t.Errorf("%q: got %v, want a ParseError", tt.input, err)
+			continue
+		}
+		if pe.Line != tt.line || pe.Col != tt.col {
+			t.Errorf("%q: got %s, want line %d, column %d", tt.input, pe, tt.line, tt.col)
+		}
+	}
+}
+
+func TestParseSectionErrors(t *testing.T) {
+	sections := Sections("0:\n1,2\n\n3,4\n5;6\n")
+	_, err := ParseSection[point](sections[1], "{X},{Y}")
+
+	var pe *ParseError
+	if !errors.As(err, &pe) || pe.Line != 5 || pe.Col != 1 {
+		t.Errorf("got %v, want line 5, column 1", err)
+	}
+}
+
+func TestParsePatternErrors(t *testing.T) {
+	for _, pattern := range []string{"{X}{Y}", "{X},{Z}", "{X...},{Y}"} {
+		_, err := Parse[point]("1,2", pattern)
+		var pe *ParseError
+		if err == nil || errors.As(err, &pe) {
+			t.Errorf("%q: got %v, want an error in the pattern", pattern, err)
+		}
+	}
+}
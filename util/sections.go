@@ -0,0 +1,106 @@
+package util
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Section is a block of lines in the input, separated from other blocks by
+// blank lines.
+type Section struct {
+	Index int
+	// Name is set for blocks whose first line is a header like "0:", the
+	// header isn't part of the text.
+	Name string
+	// Line is the 1-based line in the input the text starts on.
+	Line int
+	Text string
+}
+
+// Sections splits the input into blocks separated by lines that are empty or
+// only whitespace. CRLF line endings are turned into LF.
+func Sections(input string) []Section {
+	lines := strings.Split(strings.ReplaceAll(input, "\r\n", "\n"), "\n")
+
+	var sections []Section
+	var block []string
+	var start int
+	add := func() {
+		if len(block) == 0 {
+			return
+		}
+
+		s := Section{Index: len(sections), Line: start}
+		if header := block[0]; len(block) > 1 && strings.HasSuffix(header, ":") {
+			s.Name = strings.TrimSuffix(header, ":")
+			s.Line++
+			block = block[1:]
+		}
+		s.Text = strings.Join(block, "\n")
+		sections = append(sections, s)
+		block = nil
+	}
+
+	for i, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			add()
+			continue
+		}
+		if len(block) == 0 {
+			start = i + 1
+		}
+		block = append(block, line)
+	}
+	add()
+
+	return sections
+}
+
+// SectionsN is Sections for input that must have exactly n sections.
+func SectionsN(input string, n int) ([]Section, error) {
+	sections := Sections(input)
+	if len(sections) != n {
+		return nil, fmt.Errorf("got %d sections separated by blank lines, want %d", len(sections), n)
+	}
+	return sections, nil
+}
+
+// SectionByName returns the section with the name.
+func SectionByName(sections []Section, name string) (Section, bool) {
+	for _, s := range sections {
+		if s.Name == name {
+			return s, true
+		}
+	}
+	return Section{}, false
+}
+
+func (s Section) Lines() []string {
+	return strings.Split(s.Text, "\n")
+}
+
+// Numbers parses the section as numbers separated by the separator, errors
+// give the line in the input.
+func (s Section) Numbers(separator string) ([]int, error) {
+	return Strict.numberList(s.Text, separator, s.Line)
+}
+
+// KeyValues parses each line as a key and a value separated by the separator,
+// with whitespace around both trimmed.
+func (s Section) KeyValues(separator string) (map[string]string, error) {
+	kvs := make(map[string]string)
+	for i, line := range s.Lines() {
+		key, value, ok := strings.Cut(line, separator)
+		if !ok {
+			return nil, fmt.Errorf("line %d: no %q in %q", s.Line+i, separator, line)
+		}
+
+		key = strings.TrimSpace(key)
+		if _, ok := kvs[key]; ok {
+			return nil, fmt.Errorf("line %d: duplicate key %q", s.Line+i, key)
+		}
+		kvs[key] = strings.TrimSpace(value)
+	}
+
+	return kvs, nil
+}
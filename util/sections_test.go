@@ -0,0 +1,56 @@
+package util
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSections(t *testing.T) {
+	input := "\n0:\r\n#.\r\n.#\r\n\r\n1:\r\n##\r\n   \r\nRegister A: 729\r\nRegister B: 0\r\n\r\n1\r\n2\r\n"
+	sections, err := SectionsN(input, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []Section{
+		{Index: 0, Name: "0", Line: 3, Text: "#.\n.#"},
+		{Index: 1, Name: "1", Line: 7, Text: "##"},
+		{Index: 2, Line: 9, Text: "Register A: 729\nRegister B: 0"},
+		{Index: 3, Line: 12, Text: "1\n2"},
+	}
+	if !reflect.DeepEqual(sections, want) {
+		t.Errorf("got %+v, want %+v", sections, want)
+	}
+
+	if s, ok := SectionByName(sections, "1"); !ok || s.Index != 1 {
+		t.Errorf("section 1 = %+v, %t", s, ok)
+	}
+
+	kvs, err := sections[2].KeyValues(":")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := map[string]string{"Register A": "729", "Register B": "0"}; !reflect.DeepEqual(kvs, want) {
+		t.Errorf("key values = %v, want %v", kvs, want)
+	}
+
+	numbers, err := sections[3].Numbers("\n")
+	if err != nil || !reflect.DeepEqual(numbers, []int{1, 2}) {
+		t.Errorf("numbers = %v, %v, want [1 2]", numbers, err)
+	}
+
+	if _, err := SectionsN(input, 3); err == nil || !strings.Contains(err.Error(), "got 4 sections") {
+		t.Errorf("got %v, want an error for the section count", err)
+	}
+}
+
+func TestSectionErrors(t *testing.T) {
+	sections := Sections("a: 1\n\nb: 1\nb: 2\n\n1\nx\n")
+	if _, err := sections[1].KeyValues(":"); err == nil || err.Error() != `line 4: duplicate key "b"` {
+		t.Errorf("got %v, want a duplicate key on line 4", err)
+	}
+	if _, err := sections[2].Numbers("\n"); err == nil || err.Error() != `line 7: invalid number "x"` {
+		t.Errorf("got %v, want an invalid number on line 7", err)
+	}
+}
@@ -2,8 +2,6 @@ package util
 
 import (
 	"fmt"
-	"os"
-	"runtime/pprof"
 	"strconv"
 	"strings"
 	"time"
@@ -36,6 +34,8 @@ func NumberList(in string, separator string) []int {
 	return list
 }
 
+// Str2Int returns 0 for anything that isn't a number, use Strict.Str2Int to
+// get an error instead.
 func Str2Int(in string) int {
 	i, _ := strconv.Atoi(in)
 	return i
@@ -72,19 +72,6 @@ func WithTime() func() {
 	return func() { fmt.Printf("time taken: %v\n", time.Now().Sub(now)) }
 }
 
-func WithProfiling() func() {
-	f, err := os.Create("profile.out")
-	if err != nil {
-		panic(err)
-	}
-
-	err = pprof.StartCPUProfile(f)
-	if err != nil {
-		panic(err)
-	}
-	return pprof.StopCPUProfile
-}
-
 func ParseInt[T int64 | int32 | int](s string) T {
 	i, err := strconv.ParseInt(s, 10, 64)
 	if err != nil {
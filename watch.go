@@ -0,0 +1,163 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mbark/aoc2025/runner"
+)
+
+// watch rebuilds and reruns a day whenever a file in its package or one of the
+// shared packages changes, showing how the answers differ from the last run.
+// The day is run by a freshly built binary so that the changes are picked up.
+func watch(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("watch", flag.ExitOnError)
+	var (
+		flagYear = yearFlag(flags)
+		flagDay  = flags.Int("day", 0, "day to watch")
+		interval = flags.Duration("interval", 500*time.Millisecond, "how often to check for changes")
+	)
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "usage: watch -day N [flags] [-- run flags]")
+		flags.PrintDefaults()
+	}
+	_ = flags.Parse(args)
+
+	dir := dayDir(*flagYear, *flagDay)
+	if _, err := os.Stat(dir); err != nil {
+		return fmt.Errorf("%d day %d: %w", *flagYear, *flagDay, err)
+	}
+	dirs := []string{dir, "maps", "fns", "util"}
+
+	bin := filepath.Join(os.TempDir(), "aoc-watch-"+strconv.Itoa(os.Getpid()))
+	defer os.Remove(bin)
+
+	w := watcher{year: *flagYear, day: *flagDay, bin: bin, args: flags.Args(), answers: make(map[string]string)}
+	ticker := time.NewTicker(*interval)
+	defer ticker.Stop()
+
+	var last string
+	for {
+		s, err := snapshot(dirs)
+		if err != nil {
+			return err
+		}
+		if s != last {
+			last = s
+			w.rerun(ctx)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// snapshot returns the name, size and modification time of every Go file in
+// the directories, it changes if any of them is edited, added or removed.
+func snapshot(dirs []string) (string, error) {
+	var sb strings.Builder
+	for _, dir := range dirs {
+		err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() || filepath.Ext(path) != ".go" {
+				return err
+			}
+
+			info, err := d.Info()
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(&sb, "%s %d %d\n", path, info.Size(), info.ModTime().UnixNano())
+			return nil
+		})
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return "", err
+		}
+	}
+
+	return sb.String(), nil
+}
+
+type watcher struct {
+	year int
+	day  int
+	bin  string
+	args []string
+	// answers are the last answers given, keyed by input and part.
+	answers map[string]string
+}
+
+// rerun builds the binary and runs the examples, and then the input if every
+// example passed.
+func (w *watcher) rerun(ctx context.Context) {
+	fmt.Printf("\n--- %s\n", time.Now().Format(time.TimeOnly))
+
+	build := exec.CommandContext(ctx, "go", "build", "-o", w.bin, ".")
+	build.Stdout, build.Stderr = os.Stderr, os.Stderr
+	if err := build.Run(); err != nil {
+		fmt.Println("build failed")
+		return
+	}
+
+	if !w.run(ctx, "-test") {
+		fmt.Println("skipping the input until the examples pass")
+		return
+	}
+	w.run(ctx)
+}
+
+// run runs the day and prints its results, it returns false if any of them
+// failed.
+func (w *watcher) run(ctx context.Context, args ...string) bool {
+	args = append([]string{"run", "-year", strconv.Itoa(w.year), "-day", strconv.Itoa(w.day), "-format", "json"}, args...)
+	var stdout bytes.Buffer
+	cmd := exec.CommandContext(ctx, w.bin, append(args, w.args...)...)
+	cmd.Stdout, cmd.Stderr = &stdout, os.Stderr
+	// a failing part also makes run exit with an error, the results say which
+	_ = cmd.Run()
+
+	results, err := runner.ReadJSON(&stdout)
+	if err != nil {
+		fmt.Println("read results:", err)
+		return false
+	}
+
+	ok := len(results) > 0
+	for _, r := range results {
+		name := runner.InputName(r.Example)
+		key := fmt.Sprintf("%s part %d", name, r.Part)
+
+		var msg string
+		switch r.Status() {
+		case "timeout":
+			ok, msg = false, "timeout"
+		case "error":
+			ok, msg = false, "error: "+r.Err.Error()
+		case "wrong":
+			ok, msg = false, fmt.Sprintf("%s, want %s", r.Answer, r.Expected)
+		default:
+			msg = r.Answer.String()
+		}
+
+		if r.Err == nil {
+			if prev, seen := w.answers[key]; seen && prev != r.Answer.String() {
+				msg += fmt.Sprintf(" (was %s)", prev)
+			}
+			w.answers[key] = r.Answer.String()
+		}
+		fmt.Printf("%s: %s (%s)\n", key, msg, r.Duration.Round(time.Microsecond))
+	}
+	return ok
+}